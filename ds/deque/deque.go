@@ -0,0 +1,140 @@
+package deque
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/felipebool/dsa/ds/internal/ring"
+)
+
+// defaultCapacity is the smallest backing array a Deque holds.
+const defaultCapacity = 4
+
+// Deque is the structure that holds the elements in the Deque,
+// a double-ended queue where elements can be added and removed
+// at both ends in O(1) amortized. The elements live in the same
+// circular buffer that backs queue.Queue, which grows and shrinks
+// as elements are added and removed. It has also a sync.Mutex to
+// ensure goroutine safety.
+type Deque struct {
+	mu       sync.Mutex
+	elements ring.Ring
+}
+
+// PushFront adds a new element to the front of the Deque.
+func (d *Deque) PushFront(element any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.elements.PushFront(element)
+}
+
+// PushBack adds a new element to the back of the Deque.
+func (d *Deque) PushBack(element any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.elements.PushBack(element)
+}
+
+// PopFront removes the element at the front of the Deque
+// and returns it. It returns nil if the Deque is empty.
+func (d *Deque) PopFront() any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.PopFront()
+}
+
+// PopBack removes the element at the back of the Deque
+// and returns it. It returns nil if the Deque is empty.
+func (d *Deque) PopBack() any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.PopBack()
+}
+
+// PeekFront returns the element at the front of the Deque
+// without removing it. It returns nil if the Deque is empty.
+func (d *Deque) PeekFront() any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.At(0)
+}
+
+// PeekBack returns the element at the back of the Deque
+// without removing it. It returns nil if the Deque is empty.
+func (d *Deque) PeekBack() any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.At(d.elements.Len() - 1)
+}
+
+// At returns the i-th element counting from the front of the
+// Deque, in O(1). It returns nil if i is out of range.
+func (d *Deque) At(i int) any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.At(i)
+}
+
+// Rotate rotates the Deque n steps to the right, moving the last
+// n elements to the front, or n steps to the left if n is negative,
+// moving the first -n elements to the back. It takes O(min(k, len-k))
+// steps, where k is n modulo the length of the Deque.
+func (d *Deque) Rotate(n int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.elements.Rotate(n)
+}
+
+// IsEmpty returns true if the Deque has no elements,
+// false otherwise.
+func (d *Deque) IsEmpty() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.Len() == 0
+}
+
+// Len returns the number of elements in the Deque.
+func (d *Deque) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.elements.Len()
+}
+
+// String returns a string representation of the Deque, from
+// front to back, it is useful for debugging and visualization.
+// It returns "[]" if the Deque is empty.
+func (d *Deque) String() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := ""
+	if d.elements.Len() == 0 {
+		return "[]"
+	}
+
+	for i := range d.elements.Len() {
+		if i == d.elements.Len()-1 {
+			result += fmt.Sprintf("[%+v]", d.elements.At(i))
+			break
+		}
+		result += fmt.Sprintf("[%+v] -> ", d.elements.At(i))
+	}
+	return result
+}
+
+// NewDeque returns a new Deque with no elements.
+func NewDeque() *Deque {
+	return &Deque{
+		elements: ring.New(defaultCapacity),
+	}
+}
@@ -0,0 +1,157 @@
+package deque_test
+
+import (
+	"testing"
+
+	"github.com/felipebool/dsa/ds/deque"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque(t *testing.T) {
+	type item struct {
+		value string
+	}
+
+	testCases := map[string]struct {
+		elementsToPushBack  []any
+		elementsToPushFront []any
+		expectedString      string
+		expectedFront       any
+		expectedBack        any
+	}{
+		"only back": {
+			elementsToPushBack: []any{1, 2, 3},
+			expectedString:     "[1] -> [2] -> [3]",
+			expectedFront:      1,
+			expectedBack:       3,
+		},
+		"only front": {
+			elementsToPushFront: []any{"a", "b", "c"},
+			expectedString:      "[c] -> [b] -> [a]",
+			expectedFront:       "c",
+			expectedBack:        "a",
+		},
+		"both ends": {
+			elementsToPushBack:  []any{3, 4, 5, 6, 7},
+			elementsToPushFront: []any{2, 1, 0},
+			expectedString:      "[0] -> [1] -> [2] -> [3] -> [4] -> [5] -> [6] -> [7]",
+			expectedFront:       0,
+			expectedBack:        7,
+		},
+		"mixed types": {
+			elementsToPushBack:  []any{"b", item{value: "c"}},
+			elementsToPushFront: []any{1},
+			expectedString:      "[1] -> [b] -> [{value:c}]",
+			expectedFront:       1,
+			expectedBack:        item{value: "c"},
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			d := deque.NewDeque()
+			for i := range tc.elementsToPushBack {
+				d.PushBack(tc.elementsToPushBack[i])
+			}
+			for i := range tc.elementsToPushFront {
+				d.PushFront(tc.elementsToPushFront[i])
+			}
+
+			assert.Equal(t, tc.expectedString, d.String())
+			assert.Equal(t, tc.expectedFront, d.PeekFront())
+			assert.Equal(t, tc.expectedBack, d.PeekBack())
+			assert.Equal(t, tc.expectedFront, d.At(0))
+			assert.Equal(t, tc.expectedBack, d.At(d.Len()-1))
+			assert.Nil(t, d.At(d.Len()))
+			assert.Nil(t, d.At(-1))
+			assert.False(t, d.IsEmpty())
+
+			assert.Equal(t, tc.expectedFront, d.PopFront())
+			if d.Len() > 0 {
+				assert.Equal(t, tc.expectedBack, d.PopBack())
+			}
+			for !d.IsEmpty() {
+				d.PopBack()
+			}
+
+			assert.Equal(t, "[]", d.String())
+			assert.Nil(t, d.PopFront())
+			assert.Nil(t, d.PopBack())
+			assert.Nil(t, d.PeekFront())
+			assert.Nil(t, d.PeekBack())
+		})
+	}
+}
+
+func TestDequeRotate(t *testing.T) {
+	testCases := map[string]struct {
+		elements       []any
+		popFront       int
+		rotate         int
+		expectedString string
+	}{
+		"rotate right": {
+			elements:       []any{1, 2, 3, 4, 5},
+			rotate:         2,
+			expectedString: "[4] -> [5] -> [1] -> [2] -> [3]",
+		},
+		"rotate left": {
+			elements:       []any{1, 2, 3, 4, 5},
+			rotate:         -2,
+			expectedString: "[3] -> [4] -> [5] -> [1] -> [2]",
+		},
+		"rotate right past half": {
+			elements:       []any{1, 2, 3, 4, 5},
+			rotate:         4,
+			expectedString: "[2] -> [3] -> [4] -> [5] -> [1]",
+		},
+		"rotate more than length": {
+			elements:       []any{1, 2, 3, 4, 5},
+			rotate:         11,
+			expectedString: "[5] -> [1] -> [2] -> [3] -> [4]",
+		},
+		"rotate full buffer": {
+			elements:       []any{1, 2, 3, 4},
+			rotate:         1,
+			expectedString: "[4] -> [1] -> [2] -> [3]",
+		},
+		"rotate wrapped buffer": {
+			elements:       []any{1, 2, 3, 4, 5, 6, 7},
+			popFront:       3,
+			rotate:         -1,
+			expectedString: "[5] -> [6] -> [7] -> [4]",
+		},
+		"rotate by length": {
+			elements:       []any{1, 2, 3},
+			rotate:         3,
+			expectedString: "[1] -> [2] -> [3]",
+		},
+		"rotate single element": {
+			elements:       []any{1},
+			rotate:         7,
+			expectedString: "[1]",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			d := deque.NewDeque()
+			for i := range tc.elements {
+				d.PushBack(tc.elements[i])
+			}
+			for range tc.popFront {
+				d.PopFront()
+			}
+
+			d.Rotate(tc.rotate)
+			assert.Equal(t, tc.expectedString, d.String())
+			assert.Equal(t, len(tc.elements)-tc.popFront, d.Len())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package heap
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes the Heap to w in the Graphviz DOT language,
+// drawing the implicit tree of the underlying slice, where the
+// children of the element at position i are the ones at 2i+1
+// and 2i+2. Each node is labelled with the element's key if it
+// implements element.Getter, or its default format otherwise.
+func (h *Heap[T]) WriteDOT(w io.Writer) error {
+	return h.WriteDOTFunc(w, label[T])
+}
+
+// WriteDOTFunc is like WriteDOT, but labels each node with the
+// text returned by label.
+func (h *Heap[T]) WriteDOTFunc(w io.Writer, label func(el T) string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var result strings.Builder
+	result.WriteString("digraph Heap {\n")
+	result.WriteString("\tnode [shape=circle];\n")
+	for i, handle := range h.elements {
+		fmt.Fprintf(&result, "\tn%d [label=%q];\n", i, label(handle.value))
+		left, right := h.getChildren(i)
+		if left >= 0 {
+			fmt.Fprintf(&result, "\tn%d -> n%d;\n", i, left)
+		}
+		if right >= 0 {
+			fmt.Fprintf(&result, "\tn%d -> n%d;\n", i, right)
+		}
+	}
+	result.WriteString("}\n")
+
+	_, err := io.WriteString(w, result.String())
+	return err
+}
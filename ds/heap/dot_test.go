@@ -0,0 +1,67 @@
+package heap_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/heap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestHeapWriteDOT(t *testing.T) {
+	testCases := map[string]struct {
+		write  func(out *bytes.Buffer) error
+		golden string
+	}{
+		"max heap of keys": {
+			write: func(out *bytes.Buffer) error {
+				h := heap.NewHeap(heap.MaxHeap)
+				for _, key := range []int{17, 2, 15, 23, 4, 9, 0} {
+					h.Push(item{key: key})
+				}
+				return h.WriteDOT(out)
+			},
+			golden: "heap.dot",
+		},
+		"min heap of strings with custom labels": {
+			write: func(out *bytes.Buffer) error {
+				h := heap.NewOrderedHeap[string](heap.MinHeap)
+				h.Heapify([]string{"pear", "apple", "fig", "banana"})
+				return h.WriteDOTFunc(out, strings.ToUpper)
+			},
+			golden: "heap_labels.dot",
+		},
+		"no elements": {
+			write: func(out *bytes.Buffer) error {
+				return heap.NewHeapFrom(heap.MinHeap, []element.Getter{}).WriteDOT(out)
+			},
+			golden: "heap_empty.dot",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			require.NoError(t, tc.write(&out))
+
+			golden := filepath.Join("testdata", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, out.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), out.String())
+		})
+	}
+}
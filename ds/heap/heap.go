@@ -1,7 +1,10 @@
 package heap
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/felipebool/dsa/ds/element"
@@ -17,103 +20,225 @@ const (
 // 1 for MaxHeap
 type CompareType int
 
-type compareFn func(x, y int) bool
+type compareFn[T any] func(x, y T) bool
+
+var (
+	// ErrInvalidHandle is returned when a Handle does not refer to
+	// an element currently stored in the Heap, either because it was
+	// already removed or because it belongs to another Heap.
+	ErrInvalidHandle = errors.New("heap: invalid handle")
+
+	// ErrNotSetter is returned by Update when the element referred
+	// by the Handle does not implement element.Setter.
+	ErrNotSetter = errors.New("heap: element does not implement element.Setter")
+
+	// ErrKeyNotSet is returned by Update when SetKey leaves the
+	// key returned by GetKey unchanged, as it happens when SetKey
+	// has a value receiver and sets the key of a copy.
+	ErrKeyNotSet = errors.New("heap: SetKey did not change the key")
+)
+
+// Handle refers to an element pushed to a Heap. It keeps track
+// of the element's position while siftUp and siftDown move it
+// around, so it can be used to update or remove the element in
+// O(log n). A Handle is invalidated once its element leaves the
+// Heap through Pop or Remove. Its fields are guarded by the
+// Heap's sync.Mutex, so the element is read through Heap.Value.
+type Handle[T any] struct {
+	value T
+	index int
+}
 
 // Heap is the structure that holds the elements in the Heap,
-// it has a slice of Element, which is an interface that defines
-// a method called GetKey(), used to get the key to place the
-// elements in the Heap, a comparer which is a function
-// that receives two integers and returns a boolean, if the Heap
-// is a MaxHeap, returns true when the first element is larger than
-// the second, and if it is a MinHeap, returns true when the first
-// element is smaller than the second one. It has also a sync.Mutex
-// to ensure goroutine safety.
-type Heap struct {
+// it has a slice of Handle, wrapping elements of type T, which
+// can be any type, and a comparer, which is a function that
+// receives two elements and returns true when the first one
+// must be placed closer to the root than the second one.
+// For a MaxHeap, it returns true when the
+// first element is larger than the second, and for a MinHeap,
+// returns true when the first element is smaller than the second
+// one. It has also a sync.Mutex to ensure goroutine safety.
+type Heap[T any] struct {
 	mu       sync.Mutex
-	elements []element.Getter
-	comparer compareFn
+	elements []*Handle[T]
+	comparer compareFn[T]
 }
 
-// Peek returns the element with the smallest key in a MinHeap
-// and the element with the largest key in a MaxHeap, without
-// removing it from the Heap. It returns nil if the Heap is empty.
-func (h *Heap) Peek() element.Getter {
+// Peek returns the element closest to the root according to the
+// comparer, without removing it from the Heap. It returns the zero
+// value of T (nil for interfaces and pointers) if the Heap is empty.
+func (h *Heap[T]) Peek() T {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
 	if len(h.elements) == 0 {
-		return nil
+		var zero T
+		return zero
 	}
-	return h.elements[0]
+	return h.elements[0].value
 }
 
-// Pop removes the element with the smallest key in a MinHeap
-// and the element with the largest key in a MaxHeap, and returns it.
-// It also restores the Heap condition by calling siftDown and will
-// return nil if the Heap is empty.
-func (h *Heap) Pop() element.Getter {
+// Pop removes the element closest to the root according to the
+// comparer and returns it. It also restores the Heap condition by
+// calling siftDown and will return the zero value of T if the Heap
+// is empty.
+func (h *Heap[T]) Pop() T {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
 	if len(h.elements) == 0 {
-		return nil
+		var zero T
+		return zero
 	}
 
-	// remove root element
-	el := h.elements[0]
-
-	// place the last element in the root position
-	h.elements[0] = h.elements[len(h.elements)-1]
-
-	// resize the list by removing the last element
-	h.elements = h.elements[:len(h.elements)-1]
-
-	h.siftDown(0)
-
-	return el
+	return h.remove(0)
 }
 
 // Push inserts a new element in the Heap, it does
 // by adding the element in the last position of the
 // Heap and then calling siftUp to restore the Heap condition.
-func (h *Heap) Push(x element.Getter) {
+// It returns a Handle that can be used to Update or Remove
+// the element later on.
+func (h *Heap[T]) Push(x T) *Handle[T] {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	handle := &Handle[T]{value: x, index: len(h.elements)}
 	if len(h.elements) == 0 {
-		h.elements = append(h.elements, x)
-		return
+		h.elements = append(h.elements, handle)
+		return handle
 	}
 
 	// add element to the end of the heap
-	h.elements = append(h.elements, x)
+	h.elements = append(h.elements, handle)
 
 	h.siftUp(len(h.elements) - 1)
+	return handle
+}
+
+// Value returns the element referred by handle. It returns
+// the zero value of T and ErrInvalidHandle if the handle
+// is not in the Heap.
+func (h *Heap[T]) Value(handle *Handle[T]) (T, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.contains(handle) {
+		var zero T
+		return zero, ErrInvalidHandle
+	}
+	return handle.value, nil
+}
+
+// Update sets the key of the element referred by handle to
+// newKey, by calling SetKey on it, and restores the Heap
+// condition in O(log n). It returns ErrNotSetter if the element
+// does not implement element.Setter and ErrInvalidHandle if
+// the handle is not in the Heap. Note that SetKey must have a
+// pointer receiver for the new key to be observed: if the element
+// implements element.Getter and GetKey does not return newKey
+// afterwards, the Heap is left untouched and ErrKeyNotSet is
+// returned.
+func (h *Heap[T]) Update(handle *Handle[T], newKey int) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.contains(handle) {
+		return ErrInvalidHandle
+	}
+	setter, ok := any(handle.value).(element.Setter)
+	if !ok {
+		return ErrNotSetter
+	}
+	setter.SetKey(newKey)
+	if getter, ok := any(handle.value).(element.Getter); ok && getter.GetKey() != newKey {
+		return ErrKeyNotSet
+	}
+	h.fix(handle.index)
+	return nil
+}
+
+// Replace swaps the element referred by handle by x and
+// restores the Heap condition in O(log n). It is the
+// counterpart of Update for elements that are not
+// element.Setter, such as plain strings or numbers.
+// It returns ErrInvalidHandle if the handle is not in the Heap.
+func (h *Heap[T]) Replace(handle *Handle[T], x T) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.contains(handle) {
+		return ErrInvalidHandle
+	}
+	handle.value = x
+	h.fix(handle.index)
+	return nil
+}
+
+// Remove removes the element referred by handle from the Heap,
+// wherever it is, and returns it. It returns ErrInvalidHandle
+// if the handle is not in the Heap.
+func (h *Heap[T]) Remove(handle *Handle[T]) (T, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.contains(handle) {
+		var zero T
+		return zero, ErrInvalidHandle
+	}
+	return h.remove(handle.index), nil
 }
 
 // IsEmpty returns true when there are no elements in the
 // Heap, false otherwise.
-func (h *Heap) IsEmpty() bool {
+func (h *Heap[T]) IsEmpty() bool {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
 	return len(h.elements) == 0
 }
 
-// Heapify receives a slice of elements and pushes them to the
-// Heap by calling Push to each element. If Heapify is called
-// in a non-empty Heap, the current elements will be preserved
-// and the new ones will be pushed to the Heap.
-func (h *Heap) Heapify(elements []element.Getter) {
-	for _, el := range elements {
-		h.Push(el)
+// Len returns the number of elements in the Heap.
+func (h *Heap[T]) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.elements)
+}
+
+// Heapify receives a slice of elements, appends them to the
+// Heap and restores the Heap condition bottom-up, calling
+// siftDown on every internal node from the last one to the
+// root (Floyd's method). It runs in O(n) under a single lock,
+// instead of the O(n log n) of pushing each element. If Heapify
+// is called in a non-empty Heap, the current elements will be
+// preserved and the new ones will be added to the Heap.
+// It returns the Handles of the new elements, in the same
+// order as elements.
+func (h *Heap[T]) Heapify(elements []T) []*Handle[T] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// each Handle is allocated on its own, as in Push, so a
+	// Handle kept by the caller does not keep the others alive
+	handles := make([]*Handle[T], len(elements))
+	h.elements = slices.Grow(h.elements, len(elements))
+	for i, el := range elements {
+		handles[i] = &Handle[T]{value: el, index: len(h.elements)}
+		h.elements = append(h.elements, handles[i])
 	}
+
+	for i := len(h.elements)/2 - 1; i >= 0; i-- {
+		h.siftDown(i)
+	}
+	return handles
 }
 
 // String returns a string representation of the Heap,
-// it is useful for debugging and visualization. It returns
-// "[]" if the Heap is empty.
-func (h *Heap) String() string {
+// it is useful for debugging and visualization. Elements
+// implementing element.Getter are printed by their key.
+// It returns "[]" if the Heap is empty.
+func (h *Heap[T]) String() string {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -123,17 +248,56 @@ func (h *Heap) String() string {
 	}
 
 	for i := range h.elements {
-		el := h.elements[i]
+		el := h.elements[i].value
 		if i == len(h.elements)-1 {
-			result += fmt.Sprintf("[%d]", el.GetKey())
+			result += fmt.Sprintf("[%s]", label(el))
 			continue
 		}
-		result += fmt.Sprintf("[%d] -> ", el.GetKey())
+		result += fmt.Sprintf("[%s] -> ", label(el))
 	}
 	return result
 }
 
-func (h *Heap) siftDown(x int) {
+// remove takes the element at position x out of the Heap by
+// moving the last element to its place, and then restores the
+// Heap condition around x.
+func (h *Heap[T]) remove(x int) T {
+	handle := h.elements[x]
+
+	// place the last element in the removed position
+	last := len(h.elements) - 1
+	h.swap(x, last)
+
+	// resize the list by removing the last element, clearing
+	// the slot so the removed element is not kept reachable
+	h.elements[last] = nil
+	h.elements = h.elements[:last]
+	handle.index = -1
+
+	if x < len(h.elements) {
+		h.fix(x)
+	}
+	return handle.value
+}
+
+// fix restores the Heap condition after the element at
+// position x changed, moving it up or down as needed.
+func (h *Heap[T]) fix(x int) {
+	if x > 0 && h.comparer(h.elements[x].value, h.elements[h.getParent(x)].value) {
+		h.siftUp(x)
+		return
+	}
+	h.siftDown(x)
+}
+
+func (h *Heap[T]) contains(handle *Handle[T]) bool {
+	if handle == nil || handle.index < 0 || handle.index >= len(h.elements) {
+		return false
+	}
+	return h.elements[handle.index] == handle
+}
+
+func (h *Heap[T]) siftDown(x int) {
 	if len(h.elements) == 0 {
 		return
 	}
@@ -143,18 +307,18 @@ func (h *Heap) siftDown(x int) {
 		return
 	}
 
-	current := h.elements[x]
+	current := h.elements[x].value
 	if left >= 0 && right >= 0 {
-		leftChild := h.elements[left]
-		rightChild := h.elements[right]
-		if h.comparer(leftChild.GetKey(), rightChild.GetKey()) {
-			if h.comparer(leftChild.GetKey(), current.GetKey()) {
+		leftChild := h.elements[left].value
+		rightChild := h.elements[right].value
+		if h.comparer(leftChild, rightChild) {
+			if h.comparer(leftChild, current) {
 				h.swap(x, left)
 				h.siftDown(left)
 				return
 			}
 		}
-		if h.comparer(rightChild.GetKey(), current.GetKey()) {
+		if h.comparer(rightChild, current) {
 			h.swap(x, right)
 			h.siftDown(right)
 			return
@@ -163,8 +327,8 @@ func (h *Heap) siftDown(x int) {
 	}
 
 	if left >= 0 {
-		leftChild := h.elements[left]
-		if h.comparer(leftChild.GetKey(), current.GetKey()) {
+		leftChild := h.elements[left].value
+		if h.comparer(leftChild, current) {
 			h.swap(x, left)
 			h.siftDown(left)
 			return
@@ -172,8 +336,8 @@ func (h *Heap) siftDown(x int) {
 	}
 
 	if right >= 0 {
-		rightChild := h.elements[right]
-		if h.comparer(rightChild.GetKey(), current.GetKey()) {
+		rightChild := h.elements[right].value
+		if h.comparer(rightChild, current) {
 			h.swap(x, right)
 			h.siftDown(right)
 			return
@@ -181,34 +345,25 @@ func (h *Heap) siftDown(x int) {
 	}
 }
 
-func (h *Heap) siftUp(x int) {
+func (h *Heap[T]) siftUp(x int) {
 	if x == 0 {
 		return
 	}
 	parent := h.getParent(x)
-	current := h.elements[x]
-	if h.comparer(current.GetKey(), h.elements[parent].GetKey()) {
+	current := h.elements[x].value
+	if h.comparer(current, h.elements[parent].value) {
 		h.swap(x, parent)
 		h.siftUp(parent)
 	}
 }
 
-func (h *Heap) swap(x, y int) {
+func (h *Heap[T]) swap(x, y int) {
 	h.elements[x], h.elements[y] = h.elements[y], h.elements[x]
+	h.elements[x].index = x
+	h.elements[y].index = y
 }
 
-func (h *Heap) setComparer(cType CompareType) {
-	switch cType {
-	case MinHeap:
-		h.comparer = minHeap
-	case MaxHeap:
-		h.comparer = maxHeap
-	default:
-		h.comparer = minHeap
-	}
-}
-
-func (h *Heap) getChildren(x int) (int, int) {
+func (h *Heap[T]) getChildren(x int) (int, int) {
 	leftChild := 2*x + 1
 	if leftChild >= len(h.elements) {
 		leftChild = -1
@@ -220,22 +375,68 @@ func (h *Heap) getChildren(x int) (int, int) {
 	return leftChild, rightChild
 }
 
-func (h *Heap) getParent(x int) int {
+func (h *Heap[T]) getParent(x int) int {
 	return (x - 1) / 2
 }
 
-func maxHeap(x, y int) bool {
+// label returns the text used to represent an element, its key
+// when it implements element.Getter and its default format otherwise.
+func label[T any](el T) string {
+	if getter, ok := any(el).(element.Getter); ok {
+		return fmt.Sprintf("%d", getter.GetKey())
+	}
+	return fmt.Sprintf("%+v", el)
+}
+
+func orderedComparer[T cmp.Ordered](cType CompareType) compareFn[T] {
+	switch cType {
+	case MinHeap:
+		return minHeap[T]
+	case MaxHeap:
+		return maxHeap[T]
+	default:
+		return minHeap[T]
+	}
+}
+
+func maxHeap[T cmp.Ordered](x, y T) bool {
 	return x > y
 }
 
-func minHeap(x, y int) bool {
+func minHeap[T cmp.Ordered](x, y T) bool {
 	return x < y
 }
 
-// NewHeap returns a new Heap with no elements.
-func NewHeap(cType CompareType) *Heap {
-	heap := &Heap{}
-	heap.setComparer(cType)
-	heap.elements = make([]element.Getter, 0)
+// NewHeap returns a new Heap with no elements, ordering
+// element.Getter values by the key returned by GetKey().
+func NewHeap(cType CompareType) *Heap[element.Getter] {
+	byKey := orderedComparer[int](cType)
+	return NewHeapFunc(func(x, y element.Getter) bool {
+		return byKey(x.GetKey(), y.GetKey())
+	})
+}
+
+// NewHeapFrom returns a new Heap holding elements, built
+// in O(n) by Heapify.
+func NewHeapFrom(cType CompareType, elements []element.Getter) *Heap[element.Getter] {
+	heap := NewHeap(cType)
+	heap.Heapify(elements)
 	return heap
 }
+
+// NewOrderedHeap returns a new Heap with no elements for
+// any type supporting the < and > operators.
+func NewOrderedHeap[T cmp.Ordered](cType CompareType) *Heap[T] {
+	return NewHeapFunc(orderedComparer[T](cType))
+}
+
+// NewHeapFunc returns a new Heap with no elements, ordered by
+// less, which must return true when x must be placed closer to
+// the root than y. Passing a less that compares by ascending
+// order gives a MinHeap, and by descending order a MaxHeap.
+func NewHeapFunc[T any](less func(x, y T) bool) *Heap[T] {
+	return &Heap[T]{
+		elements: make([]*Handle[T], 0),
+		comparer: less,
+	}
+}
@@ -1,8 +1,11 @@
 package heap_test
 
 import (
-	"github.com/felipebool/dsa/ds/element"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/felipebool/dsa/ds/element"
 
 	"github.com/felipebool/dsa/ds/heap"
 	"github.com/stretchr/testify/assert"
@@ -16,13 +19,40 @@ func (e item) GetKey() int {
 	return e.key
 }
 
+type mutableItem struct {
+	key int
+}
+
+func (e *mutableItem) GetKey() int {
+	return e.key
+}
+
+func (e *mutableItem) SetKey(key int) {
+	e.key = key
+}
+
+// valueItem has a SetKey with a value receiver, which
+// only sets the key of a copy.
+type valueItem struct {
+	key int
+}
+
+func (e valueItem) GetKey() int {
+	return e.key
+}
+
+func (e valueItem) SetKey(key int) {
+	e.key = key
+}
+
 func TestHeap(t *testing.T) {
 	testCases := map[string]struct {
-		elements            []element.Getter
-		elementsToPop       []element.Getter
-		compareType         heap.CompareType
-		expectedPeekElement element.Getter
-		expectedString      string
+		elements              []element.Getter
+		elementsToPop         []element.Getter
+		compareType           heap.CompareType
+		expectedPeekElement   element.Getter
+		expectedString        string
+		expectedHeapifyString string
 	}{
 		"max heap random elements": {
 			elements: []element.Getter{
@@ -43,9 +73,10 @@ func TestHeap(t *testing.T) {
 				item{key: 2},
 				item{key: 0},
 			},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23] -> [17] -> [15] -> [2] -> [4] -> [9] -> [0]",
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23] -> [17] -> [15] -> [2] -> [4] -> [9] -> [0]",
+			expectedHeapifyString: "[23] -> [17] -> [15] -> [2] -> [4] -> [9] -> [0]",
 		},
 		"max heap ascending elements": {
 			elements: []element.Getter{
@@ -66,9 +97,10 @@ func TestHeap(t *testing.T) {
 				item{key: 2},
 				item{key: 0},
 			},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23] -> [9] -> [17] -> [0] -> [4] -> [2] -> [15]",
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23] -> [9] -> [17] -> [0] -> [4] -> [2] -> [15]",
+			expectedHeapifyString: "[23] -> [15] -> [17] -> [9] -> [2] -> [0] -> [4]",
 		},
 		"max heap descending elements": {
 			elements: []element.Getter{
@@ -89,16 +121,18 @@ func TestHeap(t *testing.T) {
 				item{key: 2},
 				item{key: 0},
 			},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23] -> [17] -> [15] -> [9] -> [4] -> [2] -> [0]",
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23] -> [17] -> [15] -> [9] -> [4] -> [2] -> [0]",
+			expectedHeapifyString: "[23] -> [17] -> [15] -> [9] -> [4] -> [2] -> [0]",
 		},
 		"max heap no elements": {
-			elements:            []element.Getter{},
-			elementsToPop:       []element.Getter{},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: nil,
-			expectedString:      "[]",
+			elements:              []element.Getter{},
+			elementsToPop:         []element.Getter{},
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   nil,
+			expectedString:        "[]",
+			expectedHeapifyString: "[]",
 		},
 		"max heap duplicated elements": {
 			elements: []element.Getter{
@@ -123,9 +157,10 @@ func TestHeap(t *testing.T) {
 				item{key: 2},
 				item{key: 0},
 			},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23] -> [23] -> [9] -> [15] -> [17] -> [2] -> [4] -> [0] -> [15]",
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23] -> [23] -> [9] -> [15] -> [17] -> [2] -> [4] -> [0] -> [15]",
+			expectedHeapifyString: "[23] -> [23] -> [9] -> [15] -> [17] -> [4] -> [2] -> [0] -> [15]",
 		},
 		"max heap single element": {
 			elements: []element.Getter{
@@ -134,9 +169,10 @@ func TestHeap(t *testing.T) {
 			elementsToPop: []element.Getter{
 				item{key: 23},
 			},
-			compareType:         heap.MaxHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23]",
+			compareType:           heap.MaxHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23]",
+			expectedHeapifyString: "[23]",
 		},
 		"min heap random elements": {
 			elements: []element.Getter{
@@ -157,9 +193,10 @@ func TestHeap(t *testing.T) {
 				item{key: 17},
 				item{key: 23},
 			},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: item{key: 0},
-			expectedString:      "[0] -> [4] -> [2] -> [23] -> [17] -> [15] -> [9]",
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   item{key: 0},
+			expectedString:        "[0] -> [4] -> [2] -> [23] -> [17] -> [15] -> [9]",
+			expectedHeapifyString: "[0] -> [2] -> [9] -> [23] -> [4] -> [17] -> [15]",
 		},
 		"min heap ascending elements": {
 			elements: []element.Getter{
@@ -180,9 +217,10 @@ func TestHeap(t *testing.T) {
 				item{key: 17},
 				item{key: 23},
 			},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: item{key: 0},
-			expectedString:      "[0] -> [2] -> [4] -> [9] -> [15] -> [17] -> [23]",
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   item{key: 0},
+			expectedString:        "[0] -> [2] -> [4] -> [9] -> [15] -> [17] -> [23]",
+			expectedHeapifyString: "[0] -> [2] -> [4] -> [9] -> [15] -> [17] -> [23]",
 		},
 		"min heap descending elements": {
 			elements: []element.Getter{
@@ -203,16 +241,18 @@ func TestHeap(t *testing.T) {
 				item{key: 17},
 				item{key: 23},
 			},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: item{key: 0},
-			expectedString:      "[0] -> [9] -> [2] -> [23] -> [15] -> [17] -> [4]",
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   item{key: 0},
+			expectedString:        "[0] -> [9] -> [2] -> [23] -> [15] -> [17] -> [4]",
+			expectedHeapifyString: "[0] -> [4] -> [2] -> [9] -> [17] -> [23] -> [15]",
 		},
 		"min heap no elements": {
-			elements:            []element.Getter{},
-			elementsToPop:       []element.Getter{},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: nil,
-			expectedString:      "[]",
+			elements:              []element.Getter{},
+			elementsToPop:         []element.Getter{},
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   nil,
+			expectedString:        "[]",
+			expectedHeapifyString: "[]",
 		},
 		"min heap duplicated elements": {
 			elements: []element.Getter{
@@ -237,9 +277,10 @@ func TestHeap(t *testing.T) {
 				item{key: 23},
 				item{key: 23},
 			},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: item{key: 0},
-			expectedString:      "[0] -> [2] -> [4] -> [15] -> [23] -> [17] -> [9] -> [23] -> [15]",
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   item{key: 0},
+			expectedString:        "[0] -> [2] -> [4] -> [15] -> [23] -> [17] -> [9] -> [23] -> [15]",
+			expectedHeapifyString: "[0] -> [15] -> [2] -> [15] -> [23] -> [4] -> [9] -> [23] -> [17]",
 		},
 		"min heap single element": {
 			elements: []element.Getter{
@@ -248,9 +289,10 @@ func TestHeap(t *testing.T) {
 			elementsToPop: []element.Getter{
 				item{key: 23},
 			},
-			compareType:         heap.MinHeap,
-			expectedPeekElement: item{key: 23},
-			expectedString:      "[23]",
+			compareType:           heap.MinHeap,
+			expectedPeekElement:   item{key: 23},
+			expectedString:        "[23]",
+			expectedHeapifyString: "[23]",
 		},
 	}
 
@@ -269,7 +311,7 @@ func TestHeap(t *testing.T) {
 			}
 			assert.Equal(t, tc.expectedPeekElement, mHeap2.Peek())
 
-			assert.Equal(t, tc.expectedString, mHeap1.String())
+			assert.Equal(t, tc.expectedHeapifyString, mHeap1.String())
 			assert.Equal(t, tc.expectedString, mHeap2.String())
 			for i := range tc.elements {
 				assert.Equal(t, tc.elementsToPop[i], mHeap1.Pop())
@@ -287,3 +329,278 @@ func TestHeap(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderedHeap(t *testing.T) {
+	testCases := map[string]struct {
+		elements       []string
+		elementsToPop  []string
+		compareType    heap.CompareType
+		expectedString string
+	}{
+		"min heap strings": {
+			elements:       []string{"pear", "apple", "fig", "banana"},
+			elementsToPop:  []string{"apple", "banana", "fig", "pear"},
+			compareType:    heap.MinHeap,
+			expectedString: "[apple] -> [banana] -> [fig] -> [pear]",
+		},
+		"max heap strings": {
+			elements:       []string{"pear", "apple", "fig", "banana"},
+			elementsToPop:  []string{"pear", "fig", "banana", "apple"},
+			compareType:    heap.MaxHeap,
+			expectedString: "[pear] -> [banana] -> [fig] -> [apple]",
+		},
+		"no elements": {
+			elements:       []string{},
+			elementsToPop:  []string{},
+			compareType:    heap.MinHeap,
+			expectedString: "[]",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			h := heap.NewOrderedHeap[string](tc.compareType)
+			h.Heapify(tc.elements)
+
+			assert.Equal(t, tc.expectedString, h.String())
+			assert.Equal(t, len(tc.elements), h.Len())
+			for i := range tc.elementsToPop {
+				assert.Equal(t, tc.elementsToPop[i], h.Pop())
+			}
+
+			assert.Equal(t, "", h.Peek())
+			assert.Equal(t, "", h.Pop())
+			assert.True(t, h.IsEmpty())
+		})
+	}
+}
+
+func TestHeapFunc(t *testing.T) {
+	type task struct {
+		name     string
+		deadline time.Time
+	}
+
+	base := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tasks := []task{
+		{name: "deploy", deadline: base.Add(3 * time.Hour)},
+		{name: "review", deadline: base.Add(time.Hour)},
+		{name: "release", deadline: base.Add(5 * time.Hour)},
+		{name: "test", deadline: base.Add(2 * time.Hour)},
+	}
+
+	h := heap.NewHeapFunc(func(x, y task) bool {
+		return x.deadline.Before(y.deadline)
+	})
+	for _, tk := range tasks {
+		h.Push(tk)
+	}
+
+	assert.Equal(t, "review", h.Peek().name)
+	for _, name := range []string{"review", "test", "deploy", "release"} {
+		assert.Equal(t, name, h.Pop().name)
+	}
+	assert.True(t, h.IsEmpty())
+}
+
+func TestHeapHandles(t *testing.T) {
+	testCases := map[string]struct {
+		keys           []int
+		updates        map[int]int
+		removals       []int
+		compareType    heap.CompareType
+		expectedPopped []int
+	}{
+		"min heap decrease key": {
+			keys:           []int{17, 2, 15, 23, 4, 9, 0},
+			updates:        map[int]int{23: -1, 15: 3},
+			compareType:    heap.MinHeap,
+			expectedPopped: []int{-1, 0, 2, 3, 4, 9, 17},
+		},
+		"min heap increase key": {
+			keys:           []int{17, 2, 15, 23, 4, 9, 0},
+			updates:        map[int]int{0: 30, 2: 16},
+			compareType:    heap.MinHeap,
+			expectedPopped: []int{4, 9, 15, 16, 17, 23, 30},
+		},
+		"max heap update and remove": {
+			keys:           []int{17, 2, 15, 23, 4, 9, 0},
+			updates:        map[int]int{0: 20},
+			removals:       []int{23, 4},
+			compareType:    heap.MaxHeap,
+			expectedPopped: []int{20, 17, 15, 9, 2},
+		},
+		"min heap remove root and leaves": {
+			keys:           []int{17, 2, 15, 23, 4, 9, 0},
+			removals:       []int{0, 23, 9},
+			compareType:    heap.MinHeap,
+			expectedPopped: []int{2, 4, 15, 17},
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			h := heap.NewHeap(tc.compareType)
+			handles := make(map[int]*heap.Handle[element.Getter])
+			for _, key := range tc.keys {
+				handles[key] = h.Push(&mutableItem{key: key})
+			}
+
+			for key, newKey := range tc.updates {
+				assert.NoError(t, h.Update(handles[key], newKey))
+				updated, err := h.Value(handles[key])
+				assert.NoError(t, err)
+				assert.Equal(t, newKey, updated.GetKey())
+			}
+
+			for _, key := range tc.removals {
+				removed, err := h.Remove(handles[key])
+				assert.NoError(t, err)
+				assert.Equal(t, key, removed.GetKey())
+
+				_, err = h.Remove(handles[key])
+				assert.ErrorIs(t, err, heap.ErrInvalidHandle)
+			}
+
+			for _, key := range tc.expectedPopped {
+				assert.Equal(t, key, h.Pop().GetKey())
+			}
+			assert.True(t, h.IsEmpty())
+
+			for _, handle := range handles {
+				assert.ErrorIs(t, h.Update(handle, 0), heap.ErrInvalidHandle)
+			}
+		})
+	}
+}
+
+func TestHeapReplace(t *testing.T) {
+	h := heap.NewOrderedHeap[string](heap.MinHeap)
+	h.Push("kiwi")
+	fig := h.Push("fig")
+	h.Push("pear")
+
+	other := heap.NewOrderedHeap[string](heap.MinHeap)
+	assert.ErrorIs(t, other.Replace(fig, "apple"), heap.ErrInvalidHandle)
+
+	assert.NoError(t, h.Replace(fig, "zucchini"))
+	value, err := h.Value(fig)
+	assert.NoError(t, err)
+	assert.Equal(t, "zucchini", value)
+	assert.Equal(t, "kiwi", h.Pop())
+	assert.Equal(t, "pear", h.Pop())
+	assert.Equal(t, "zucchini", h.Pop())
+	_, err = h.Value(fig)
+	assert.ErrorIs(t, err, heap.ErrInvalidHandle)
+
+	ints := heap.NewOrderedHeap[int](heap.MinHeap)
+	one := ints.Push(1)
+	assert.ErrorIs(t, ints.Update(one, 2), heap.ErrNotSetter)
+
+	values := heap.NewHeap(heap.MinHeap)
+	values.Push(valueItem{key: 3})
+	five := values.Push(valueItem{key: 5})
+	assert.ErrorIs(t, values.Update(five, -1), heap.ErrKeyNotSet)
+	assert.Equal(t, 3, values.Pop().GetKey())
+	assert.Equal(t, 5, values.Pop().GetKey())
+}
+
+func TestHeapConcurrentReplace(t *testing.T) {
+	h := heap.NewOrderedHeap[int](heap.MinHeap)
+	handle := h.Push(0)
+	for i := 1; i < 100; i++ {
+		h.Push(i)
+	}
+
+	// run with -race: Value reads the element under
+	// the same lock Replace writes it with
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := range 1000 {
+			assert.NoError(t, h.Replace(handle, i))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 1000 {
+			_, err := h.Value(handle)
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+
+	value, err := h.Value(handle)
+	assert.NoError(t, err)
+	assert.Equal(t, 999, value)
+}
+
+func TestNewHeapFrom(t *testing.T) {
+	h := heap.NewHeapFrom(heap.MinHeap, []element.Getter{
+		item{key: 17},
+		item{key: 2},
+		item{key: 15},
+	})
+	handles := h.Heapify([]element.Getter{
+		item{key: 23},
+		&mutableItem{key: 4},
+		item{key: 0},
+	})
+
+	assert.Equal(t, 6, h.Len())
+	assert.NoError(t, h.Update(handles[1], -5))
+	for _, key := range []int{-5, 0, 2, 15, 17, 23} {
+		assert.Equal(t, key, h.Pop().GetKey())
+	}
+	assert.True(t, h.IsEmpty())
+}
+
+const benchmarkSize = 1_000_000
+
+// benchmarkInputs returns the inputs used by the construction
+// benchmarks: keys spread randomly over the range, and keys in
+// descending order, which is the worst case for pushing into a
+// MinHeap since every element sifts up to the root.
+func benchmarkInputs() map[string][]element.Getter {
+	random := make([]element.Getter, benchmarkSize)
+	descending := make([]element.Getter, benchmarkSize)
+	for i := range benchmarkSize {
+		random[i] = item{key: (i * 7919) % benchmarkSize}
+		descending[i] = item{key: benchmarkSize - i}
+	}
+	return map[string][]element.Getter{
+		"random":     random,
+		"descending": descending,
+	}
+}
+
+func BenchmarkHeapify(b *testing.B) {
+	for label, elements := range benchmarkInputs() {
+		b.Run(label, func(b *testing.B) {
+			for range b.N {
+				h := heap.NewHeap(heap.MinHeap)
+				h.Heapify(elements)
+			}
+		})
+	}
+}
+
+func BenchmarkPushEach(b *testing.B) {
+	for label, elements := range benchmarkInputs() {
+		b.Run(label, func(b *testing.B) {
+			for range b.N {
+				h := heap.NewHeap(heap.MinHeap)
+				for _, el := range elements {
+					h.Push(el)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// This package implements the growable circular buffer
+// shared by queue.Queue and deque.Deque
+
+package ring
+
+// Ring is a circular buffer of elements. The elements are stored
+// in elements[head], elements[head+1], ..., wrapping around the
+// end of the slice, and count tells how many of them are in use.
+// It grows by doubling when it is full and shrinks by half when
+// it is only a quarter full, so pushing and popping at both ends
+// are O(1) amortized, and never goes below minCapacity. Popped
+// slots are cleared so the Ring does not keep removed elements
+// reachable. The zero value is an empty Ring with a minimum
+// capacity of 1. A Ring is not safe for concurrent use, the
+// structures built on it guard it with their own locks.
+type Ring struct {
+	elements    []any
+	head        int
+	count       int
+	minCapacity int
+}
+
+// PushBack adds element after the last one.
+func (r *Ring) PushBack(element any) {
+	r.grow()
+	r.elements[r.index(r.count)] = element
+	r.count++
+}
+
+// PushFront adds element before the first one.
+func (r *Ring) PushFront(element any) {
+	r.grow()
+	r.head = r.index(-1)
+	r.elements[r.head] = element
+	r.count++
+}
+
+// PopFront removes the first element and returns it,
+// or nil if the Ring is empty.
+func (r *Ring) PopFront() any {
+	if r.count == 0 {
+		return nil
+	}
+	element := r.elements[r.head]
+	r.elements[r.head] = nil
+	r.head = r.index(1)
+	r.count--
+	r.shrink()
+	return element
+}
+
+// PopBack removes the last element and returns it,
+// or nil if the Ring is empty.
+func (r *Ring) PopBack() any {
+	if r.count == 0 {
+		return nil
+	}
+	last := r.index(r.count - 1)
+	element := r.elements[last]
+	r.elements[last] = nil
+	r.count--
+	r.shrink()
+	return element
+}
+
+// At returns the i-th element counting from the first
+// one, or nil if i is out of range.
+func (r *Ring) At(i int) any {
+	if i < 0 || i >= r.count {
+		return nil
+	}
+	return r.elements[r.index(i)]
+}
+
+// Len returns the number of elements in the Ring.
+func (r *Ring) Len() int {
+	return r.count
+}
+
+// Rotate rotates the Ring n steps to the right, moving the last
+// n elements to the front, or n steps to the left if n is negative,
+// moving the first -n elements to the back. It takes O(min(k, len-k))
+// steps, where k is n modulo the length of the Ring.
+func (r *Ring) Rotate(n int) {
+	if r.count <= 1 {
+		return
+	}
+	n %= r.count
+	if n < 0 {
+		n += r.count
+	}
+
+	// a full buffer has no free slots to move elements
+	// through, so rotating is just moving the head
+	if r.count == len(r.elements) {
+		r.head = r.index(-n)
+		return
+	}
+
+	if n <= r.count/2 {
+		for range n {
+			r.head = r.index(-1)
+			tail := r.index(r.count)
+			r.elements[r.head] = r.elements[tail]
+			r.elements[tail] = nil
+		}
+		return
+	}
+	for range r.count - n {
+		r.elements[r.index(r.count)] = r.elements[r.head]
+		r.elements[r.head] = nil
+		r.head = r.index(1)
+	}
+}
+
+// index returns the position in the buffer of the i-th element
+// counting from the head, i can be negative or go past the end.
+func (r *Ring) index(i int) int {
+	return ((r.head+i)%len(r.elements) + len(r.elements)) % len(r.elements)
+}
+
+func (r *Ring) grow() {
+	if r.count == len(r.elements) {
+		r.resize(max(2*len(r.elements), r.minCapacity, 1))
+	}
+}
+
+func (r *Ring) shrink() {
+	if half := len(r.elements) / 2; r.count <= half/2 && half >= max(r.minCapacity, 1) {
+		r.resize(half)
+	}
+}
+
+func (r *Ring) resize(capacity int) {
+	elements := make([]any, capacity)
+	for i := range r.count {
+		elements[i] = r.elements[r.index(i)]
+	}
+	r.elements = elements
+	r.head = 0
+}
+
+// New returns an empty Ring whose buffer never
+// goes below minCapacity elements.
+func New(minCapacity int) Ring {
+	return Ring{
+		elements:    make([]any, minCapacity),
+		minCapacity: minCapacity,
+	}
+}
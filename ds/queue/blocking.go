@@ -0,0 +1,171 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/felipebool/dsa/ds/internal/ring"
+)
+
+// ErrClosed is returned by Put and Take once the BlockingQueue
+// has been closed.
+var ErrClosed = errors.New("queue: closed")
+
+// BlockingQueue is a Queue with a fixed capacity meant to be shared
+// by producers and consumers. Put blocks while the BlockingQueue is
+// full and Take blocks while it is empty, both giving up when their
+// context is done. Waiters are woken up by closing the notEmpty and
+// notFull channels, which are replaced by new ones every time they
+// are closed. It has also a sync.Mutex to ensure goroutine safety.
+// Unlike Queue, the zero value is not usable, since it has neither
+// a capacity nor the channels, so a BlockingQueue must be created
+// by NewBlockingQueue.
+type BlockingQueue struct {
+	mu       sync.Mutex
+	elements ring.Ring
+	capacity int
+	closed   bool
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// Put adds a new element to the end of the BlockingQueue, waiting
+// for room if it is full. It returns the context error if ctx is done
+// before the element is added, and ErrClosed if the BlockingQueue is
+// closed, in which case the element is not added.
+func (q *BlockingQueue) Put(ctx context.Context, element any) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+		if q.elements.Len() < q.capacity {
+			q.elements.PushBack(element)
+			q.notEmpty = broadcast(q.notEmpty)
+			q.mu.Unlock()
+			return nil
+		}
+		notFull := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-notFull:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes the first element from the BlockingQueue and returns
+// it, waiting for one to be added if it is empty. It returns the
+// context error if ctx is done before an element is available. Once
+// the BlockingQueue is closed, Take keeps returning the remaining
+// elements and returns ErrClosed when there are none left.
+func (q *BlockingQueue) Take(ctx context.Context) (any, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		q.mu.Lock()
+		if q.elements.Len() > 0 {
+			element := q.elements.PopFront()
+			q.notFull = broadcast(q.notFull)
+			q.mu.Unlock()
+			return element, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return nil, ErrClosed
+		}
+		notEmpty := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-notEmpty:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Close closes the BlockingQueue and wakes up every goroutine blocked
+// in Put or Take. Subsequent calls to Put return ErrClosed, while Take
+// drains the remaining elements before returning ErrClosed. Calling
+// Close more than once has no effect.
+func (q *BlockingQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.notEmpty = broadcast(q.notEmpty)
+	q.notFull = broadcast(q.notFull)
+}
+
+// Len returns the number of elements in the BlockingQueue.
+func (q *BlockingQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.elements.Len()
+}
+
+// Cap returns the maximum number of elements the
+// BlockingQueue holds.
+func (q *BlockingQueue) Cap() int {
+	return q.capacity
+}
+
+// String returns a string representation of the BlockingQueue,
+// in the same format used by Queue. It returns "[]" if the
+// BlockingQueue is empty.
+func (q *BlockingQueue) String() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	result := ""
+	if q.elements.Len() == 0 {
+		return "[]"
+	}
+
+	for i := range q.elements.Len() {
+		if i == q.elements.Len()-1 {
+			result += fmt.Sprintf("[%+v]", q.elements.At(i))
+			break
+		}
+		result += fmt.Sprintf("[%+v] -> ", q.elements.At(i))
+	}
+	return result
+}
+
+// broadcast wakes up every goroutine waiting on ch
+// and returns a new channel for the next waiters.
+func broadcast(ch chan struct{}) chan struct{} {
+	close(ch)
+	return make(chan struct{})
+}
+
+// NewBlockingQueue returns a new BlockingQueue with no elements
+// that holds at most capacity elements. It panics if capacity
+// is not positive.
+func NewBlockingQueue(capacity int) *BlockingQueue {
+	if capacity <= 0 {
+		panic("queue: non-positive BlockingQueue capacity")
+	}
+	return &BlockingQueue{
+		elements: ring.New(capacity),
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
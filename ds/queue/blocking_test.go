@@ -0,0 +1,149 @@
+package queue_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/felipebool/dsa/ds/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	testCases := map[string]struct {
+		capacity  int
+		producers int
+		consumers int
+		perWorker int
+	}{
+		"single producer single consumer": {
+			capacity:  1,
+			producers: 1,
+			consumers: 1,
+			perWorker: 100,
+		},
+		"many producers many consumers": {
+			capacity:  4,
+			producers: 8,
+			consumers: 8,
+			perWorker: 250,
+		},
+		"capacity larger than load": {
+			capacity:  1000,
+			producers: 2,
+			consumers: 3,
+			perWorker: 100,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			q := queue.NewBlockingQueue(tc.capacity)
+
+			var producers sync.WaitGroup
+			for p := range tc.producers {
+				producers.Add(1)
+				go func() {
+					defer producers.Done()
+					for i := range tc.perWorker {
+						assert.NoError(t, q.Put(ctx, p*tc.perWorker+i))
+					}
+				}()
+			}
+
+			var mu sync.Mutex
+			seen := make(map[int]bool)
+			var consumers sync.WaitGroup
+			for range tc.consumers {
+				consumers.Add(1)
+				go func() {
+					defer consumers.Done()
+					for {
+						el, err := q.Take(ctx)
+						if err != nil {
+							assert.ErrorIs(t, err, queue.ErrClosed)
+							return
+						}
+						assert.LessOrEqual(t, q.Len(), tc.capacity)
+						mu.Lock()
+						seen[el.(int)] = true
+						mu.Unlock()
+					}
+				}()
+			}
+
+			producers.Wait()
+			q.Close()
+			consumers.Wait()
+
+			assert.Len(t, seen, tc.producers*tc.perWorker)
+			assert.Equal(t, 0, q.Len())
+		})
+	}
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	q := queue.NewBlockingQueue(2)
+	assert.Equal(t, 2, q.Cap())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	el, err := q.Take(ctx)
+	assert.Nil(t, el)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.NoError(t, q.Put(context.Background(), "a"))
+	assert.NoError(t, q.Put(context.Background(), "b"))
+	assert.Equal(t, "[a] -> [b]", q.String())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	assert.ErrorIs(t, q.Put(ctx, "c"), context.Canceled)
+	assert.ErrorIs(t, q.Put(ctx, "c"), context.Canceled)
+	assert.Equal(t, 2, q.Len())
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	ctx := context.Background()
+
+	empty := queue.NewBlockingQueue(1)
+	full := queue.NewBlockingQueue(1)
+	assert.NoError(t, full.Put(ctx, "a"))
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := empty.Take(ctx)
+			assert.ErrorIs(t, err, queue.ErrClosed)
+		}()
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(t, full.Put(ctx, "b"), queue.ErrClosed)
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	empty.Close()
+	full.Close()
+	full.Close()
+	wg.Wait()
+
+	el, err := full.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", el)
+
+	el, err = full.Take(ctx)
+	assert.Nil(t, el)
+	assert.ErrorIs(t, err, queue.ErrClosed)
+	assert.Equal(t, "[]", full.String())
+}
@@ -3,13 +3,20 @@ package queue
 import (
 	"fmt"
 	"sync"
+
+	"github.com/felipebool/dsa/ds/internal/ring"
 )
 
-// Queue is the structure that holds the elements in the Queue.
-// It has also a sync.Mutex to ensure goroutine safety.
+// defaultCapacity is the smallest backing array a Queue holds.
+const defaultCapacity = 4
+
+// Queue is the structure that holds the elements in the Queue,
+// backed by a circular buffer that grows and shrinks as elements
+// are added and removed. It has also a sync.Mutex to ensure
+// goroutine safety.
 type Queue struct {
 	mu       sync.Mutex
-	elements []any
+	elements ring.Ring
 }
 
 // Enqueue adds a new element to the end of the Queue.
@@ -17,21 +24,17 @@ func (q *Queue) Enqueue(element any) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	q.elements = append(q.elements, element)
+	q.elements.PushBack(element)
 }
 
-// Dequeue removes the first element from the Queue and resize it.
+// Dequeue removes the first element from the Queue and returns it,
+// the Queue does not keep any reference to the removed element.
 // It returns nil if the Queue is empty.
 func (q *Queue) Dequeue() any {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if len(q.elements) == 0 {
-		return nil
-	}
-	element := q.elements[0]
-	q.elements = q.elements[1:]
-	return element
+	return q.elements.PopFront()
 }
 
 // Peek returns the first element without removing
@@ -40,10 +43,7 @@ func (q *Queue) Peek() any {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if len(q.elements) == 0 {
-		return nil
-	}
-	return q.elements[0]
+	return q.elements.At(0)
 }
 
 // IsEmpty returns true if the Queue has no elements,
@@ -52,7 +52,15 @@ func (q *Queue) IsEmpty() bool {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	return len(q.elements) == 0
+	return q.elements.Len() == 0
+}
+
+// Len returns the number of elements in the Queue.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.elements.Len()
 }
 
 // String returns a string representation of the Queue,
@@ -63,16 +71,16 @@ func (q *Queue) String() string {
 	defer q.mu.Unlock()
 
 	result := ""
-	if len(q.elements) == 0 {
+	if q.elements.Len() == 0 {
 		return "[]"
 	}
 
-	for i := range q.elements {
-		if i == len(q.elements)-1 {
-			result += fmt.Sprintf("[%+v]", q.elements[i])
+	for i := range q.elements.Len() {
+		if i == q.elements.Len()-1 {
+			result += fmt.Sprintf("[%+v]", q.elements.At(i))
 			break
 		}
-		result += fmt.Sprintf("[%+v] -> ", q.elements[i])
+		result += fmt.Sprintf("[%+v] -> ", q.elements.At(i))
 	}
 	return result
 }
@@ -80,6 +88,6 @@ func (q *Queue) String() string {
 // NewQueue returns a new Queue with no elements.
 func NewQueue() *Queue {
 	return &Queue{
-		elements: make([]any, 0),
+		elements: ring.New(defaultCapacity),
 	}
 }
@@ -88,3 +88,59 @@ func TestQueue(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueWrapAround(t *testing.T) {
+	testCases := map[string]struct {
+		rounds          int
+		enqueuePerRound int
+		dequeuePerRound int
+	}{
+		"balanced": {
+			rounds:          100,
+			enqueuePerRound: 3,
+			dequeuePerRound: 3,
+		},
+		"growing": {
+			rounds:          100,
+			enqueuePerRound: 5,
+			dequeuePerRound: 2,
+		},
+		"growing then draining": {
+			rounds:          50,
+			enqueuePerRound: 40,
+			dequeuePerRound: 1,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			var q queue.Queue
+			next, expected := 0, 0
+			for range tc.rounds {
+				for range tc.enqueuePerRound {
+					q.Enqueue(next)
+					next++
+				}
+				for range tc.dequeuePerRound {
+					assert.Equal(t, expected, q.Dequeue())
+					expected++
+				}
+				assert.Equal(t, next-expected, q.Len())
+				if next > expected {
+					assert.Equal(t, expected, q.Peek())
+				}
+			}
+
+			for !q.IsEmpty() {
+				assert.Equal(t, expected, q.Dequeue())
+				expected++
+			}
+			assert.Equal(t, next, expected)
+			assert.Equal(t, "[]", q.String())
+			assert.Nil(t, q.Dequeue())
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// This package implements an AVL tree, a binary search tree
+// that keeps itself height-balanced by rotations
+
+package avl
+
+import (
+	"fmt"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+const (
+	InOrder TraverseAlgorithm = iota
+	PreOrder
+	PostOrder
+)
+
+type TraverseAlgorithm int
+
+// Node represents a node in a Tree. Each node has an
+// element which implements the Element interface
+// (GetKey() int), two other Nodes and the height of the
+// subtree rooted at it. The heights of the left and right
+// subtrees of any Node differ by at most one.
+type Node struct {
+	element element.GetterSetter
+	left    *Node
+	right   *Node
+	height  int
+}
+
+// Element returns the element stored in the Node.
+func (n *Node) Element() element.GetterSetter {
+	return n.element
+}
+
+// Tree is an AVL tree. Unlike binary.Tree, it rebalances
+// itself after every Insert and Remove, so its height is at
+// most ~1.44 log2(n) and Search, Insert and Remove are
+// O(log n) whatever the insertion order.
+type Tree struct {
+	root *Node
+	size int
+}
+
+// Insert adds element to the Tree, rebalancing the nodes
+// in the path from the root to the new one. An element whose
+// key is already in the Tree gets a Node of its own, which
+// comes after the existing ones in InOrder.
+func (t *Tree) Insert(element element.GetterSetter) {
+	t.root = t.insert(t.root, element)
+	t.size++
+}
+
+// Search returns the Node holding key, or nil if
+// there is no such Node in the Tree.
+func (t *Tree) Search(key int) *Node {
+	current := t.root
+	for current != nil {
+		if key > current.element.GetKey() {
+			current = current.right
+			continue
+		}
+		if key < current.element.GetKey() {
+			current = current.left
+			continue
+		}
+		return current
+	}
+	return nil
+}
+
+// Remove removes a Node holding key from the Tree, if any,
+// rebalancing the nodes in the path from the root to it.
+func (t *Tree) Remove(key int) {
+	var removed bool
+	t.root, removed = t.remove(t.root, key)
+	if removed {
+		t.size--
+	}
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return t.size
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree) Height() int {
+	return height(t.root)
+}
+
+func (t *Tree) Traverse(algorithm TraverseAlgorithm) string {
+	switch algorithm {
+	case InOrder:
+		return t.inOrder(t.root)
+	case PreOrder:
+		return t.preOrder(t.root)
+	case PostOrder:
+		return t.postOrder(t.root)
+	default:
+		return "unknown traversal algorithm"
+	}
+}
+
+func (t *Tree) insert(root *Node, element element.GetterSetter) *Node {
+	if root == nil {
+		return &Node{element: element, height: 1}
+	}
+	if element.GetKey() < root.element.GetKey() {
+		root.left = t.insert(root.left, element)
+	} else {
+		root.right = t.insert(root.right, element)
+	}
+	return t.balance(root)
+}
+
+func (t *Tree) remove(root *Node, key int) (*Node, bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch {
+	case key < root.element.GetKey():
+		root.left, removed = t.remove(root.left, key)
+	case key > root.element.GetKey():
+		root.right, removed = t.remove(root.right, key)
+	default:
+		if root.left == nil {
+			return root.right, true
+		}
+		if root.right == nil {
+			return root.left, true
+		}
+
+		// replace the node by its in-order successor
+		right, successor := t.removeMin(root.right)
+		successor.left = root.left
+		successor.right = right
+		root.left, root.right = nil, nil
+		return t.balance(successor), true
+	}
+	return t.balance(root), removed
+}
+
+// removeMin detaches the leftmost node of the subtree rooted
+// at root and returns the new subtree root along with it.
+func (t *Tree) removeMin(root *Node) (*Node, *Node) {
+	if root.left == nil {
+		right := root.right
+		root.right = nil
+		return right, root
+	}
+	var min *Node
+	root.left, min = t.removeMin(root.left)
+	return t.balance(root), min
+}
+
+// balance restores the AVL condition on root, assuming both of
+// its subtrees are AVL trees whose heights differ by at most two,
+// and returns the new root of the subtree.
+func (t *Tree) balance(root *Node) *Node {
+	update(root)
+	switch factor := balanceFactor(root); {
+	case factor > 1:
+		// left-right case, reduced to left-left
+		if balanceFactor(root.left) < 0 {
+			root.left = t.rotateLeft(root.left)
+		}
+		return t.rotateRight(root)
+	case factor < -1:
+		// right-left case, reduced to right-right
+		if balanceFactor(root.right) > 0 {
+			root.right = t.rotateRight(root.right)
+		}
+		return t.rotateLeft(root)
+	}
+	return root
+}
+
+func (t *Tree) rotateLeft(root *Node) *Node {
+	pivot := root.right
+	root.right = pivot.left
+	pivot.left = root
+	update(root)
+	update(pivot)
+	return pivot
+}
+
+func (t *Tree) rotateRight(root *Node) *Node {
+	pivot := root.left
+	root.left = pivot.right
+	pivot.right = root
+	update(root)
+	update(pivot)
+	return pivot
+}
+
+func (t *Tree) inOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.inOrder(root.left)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.inOrder(root.right)
+	return result
+}
+
+func (t *Tree) preOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.preOrder(root.left)
+	result += t.preOrder(root.right)
+	return result
+}
+
+func (t *Tree) postOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.postOrder(root.left)
+	result += t.postOrder(root.right)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	return result
+}
+
+func height(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func update(node *Node) {
+	node.height = 1 + max(height(node.left), height(node.right))
+}
+
+func balanceFactor(node *Node) int {
+	return height(node.left) - height(node.right)
+}
+
+func NewTree() *Tree {
+	return &Tree{}
+}
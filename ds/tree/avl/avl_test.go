@@ -0,0 +1,204 @@
+package avl_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/avl"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	key int
+}
+
+func (e item) GetKey() int {
+	return e.key
+}
+
+func (e item) SetKey(key int) {
+	e.key = key
+}
+
+func TestAVLTreeInsertion(t *testing.T) {
+	testCases := map[string]struct {
+		elements          []element.GetterSetter
+		expectedInOrder   string
+		expectedPreOrder  string
+		expectedPostOrder string
+		expectedHeight    int
+	}{
+		"random elements": {
+			elements: []element.GetterSetter{
+				item{key: 8},
+				item{key: 3},
+				item{key: 10},
+				item{key: 1},
+				item{key: 6},
+				item{key: 14},
+				item{key: 4},
+				item{key: 7},
+				item{key: 13},
+			},
+			expectedInOrder:   "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder:  "[8] [3] [1] [6] [4] [7] [13] [10] [14] ",
+			expectedPostOrder: "[1] [4] [7] [6] [3] [10] [14] [13] [8] ",
+			expectedHeight:    4,
+		},
+		"ascending elements": {
+			elements: []element.GetterSetter{
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+				item{key: 4},
+				item{key: 5},
+				item{key: 6},
+				item{key: 7},
+			},
+			expectedInOrder:   "[1] [2] [3] [4] [5] [6] [7] ",
+			expectedPreOrder:  "[4] [2] [1] [3] [6] [5] [7] ",
+			expectedPostOrder: "[1] [3] [2] [5] [7] [6] [4] ",
+			expectedHeight:    3,
+		},
+		"descending elements": {
+			elements: []element.GetterSetter{
+				item{key: 7},
+				item{key: 6},
+				item{key: 5},
+				item{key: 4},
+				item{key: 3},
+				item{key: 2},
+				item{key: 1},
+			},
+			expectedInOrder:   "[1] [2] [3] [4] [5] [6] [7] ",
+			expectedPreOrder:  "[4] [2] [1] [3] [6] [5] [7] ",
+			expectedPostOrder: "[1] [3] [2] [5] [7] [6] [4] ",
+			expectedHeight:    3,
+		},
+		"no elements": {
+			elements:          []element.GetterSetter{},
+			expectedInOrder:   "",
+			expectedPreOrder:  "",
+			expectedPostOrder: "",
+			expectedHeight:    0,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := avl.NewTree()
+			for _, i := range tc.elements {
+				tree.Insert(i)
+			}
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(avl.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(avl.PreOrder))
+			assert.Equal(t, tc.expectedPostOrder, tree.Traverse(avl.PostOrder))
+			assert.Equal(t, tc.expectedHeight, tree.Height())
+			assert.Equal(t, len(tc.elements), tree.Len())
+
+			for _, i := range tc.elements {
+				node := tree.Search(i.GetKey())
+				if assert.NotNil(t, node) {
+					assert.Equal(t, i, node.Element())
+				}
+			}
+			assert.Nil(t, tree.Search(100))
+		})
+	}
+}
+
+func TestAVLTreeRemove(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+
+	testCases := map[string]struct {
+		elementToRemove  item
+		expectedInOrder  string
+		expectedPreOrder string
+		expectedLen      int
+	}{
+		"removing leaf node and rebalancing": {
+			elementToRemove:  item{key: 1},
+			expectedInOrder:  "[3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [6] [3] [4] [7] [13] [10] [14] ",
+			expectedLen:      8,
+		},
+		"removing node with two children": {
+			elementToRemove:  item{key: 3},
+			expectedInOrder:  "[1] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [4] [1] [6] [7] [13] [10] [14] ",
+			expectedLen:      8,
+		},
+		"removing root node": {
+			elementToRemove:  item{key: 8},
+			expectedInOrder:  "[1] [3] [4] [6] [7] [10] [13] [14] ",
+			expectedPreOrder: "[10] [3] [1] [6] [4] [7] [13] [14] ",
+			expectedLen:      8,
+		},
+		"removing missing key": {
+			elementToRemove:  item{key: 5},
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [3] [1] [6] [4] [7] [13] [10] [14] ",
+			expectedLen:      9,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := avl.NewTree()
+			for _, i := range elements {
+				tree.Insert(i)
+			}
+
+			tree.Remove(tc.elementToRemove.GetKey())
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(avl.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(avl.PreOrder))
+			assert.Equal(t, tc.expectedLen, tree.Len())
+			assert.Nil(t, tree.Search(tc.elementToRemove.GetKey()))
+		})
+	}
+}
+
+func TestAVLTreeSortedInsertionHeight(t *testing.T) {
+	const n = 100_000
+	bound := 1.44 * math.Log2(n)
+
+	tree := avl.NewTree()
+	for key := range n {
+		tree.Insert(item{key: key})
+	}
+	assert.Equal(t, n, tree.Len())
+	assert.LessOrEqual(t, float64(tree.Height()), bound)
+
+	for key := 0; key < n; key += 2 {
+		tree.Remove(key)
+	}
+	assert.Equal(t, n/2, tree.Len())
+	assert.LessOrEqual(t, float64(tree.Height()), 1.44*math.Log2(n/2))
+
+	for key := range n {
+		if key%2 == 0 {
+			assert.Nil(t, tree.Search(key))
+			continue
+		}
+		assert.NotNil(t, tree.Search(key))
+	}
+}
@@ -0,0 +1,139 @@
+package binary
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+// ErrNotSorted is returned by NewTreeFromSorted when the
+// elements are not in ascending order of key.
+var ErrNotSorted = errors.New("binary: elements not sorted")
+
+// NewTreeFromSorted returns a new Tree, configured by opts, holding
+// elements, which must be sorted in ascending order of key. Elements
+// with the same key are handled according to the DuplicatePolicy, as
+// if they were inserted in order. Instead of inserting them one by
+// one, which makes a chain out of sorted input, it takes the middle
+// key as the root and builds both subtrees the same way, which gives
+// a perfectly balanced Tree in O(n). It returns ErrNotSorted if the
+// elements are not sorted and ErrDuplicateKey if a duplicated key is
+// found with the Reject policy.
+func NewTreeFromSorted(elements []element.GetterSetter, opts ...Option) (*Tree, error) {
+	t := NewTree(opts...)
+
+	// group the elements by key, in the nodes they will be stored
+	nodes := make([]*Node, 0, len(elements))
+	for i, el := range elements {
+		if i == 0 || el.GetKey() > elements[i-1].GetKey() {
+			nodes = append(nodes, &Node{element: el})
+			continue
+		}
+		if el.GetKey() < elements[i-1].GetKey() {
+			return nil, ErrNotSorted
+		}
+
+		last := nodes[len(nodes)-1]
+		switch t.policy {
+		case Reject:
+			return nil, ErrDuplicateKey
+		case Replace:
+			last.element = el
+		default:
+			last.duplicates = append(last.duplicates, el)
+		}
+	}
+
+	t.root = t.buildBalanced(nodes, nil)
+	return t, nil
+}
+
+// Rebalance reshapes the Tree in place into a balanced one, with
+// every level full but possibly the last, using the Day-Stout-Warren
+// algorithm: the Tree is first turned into a chain of right children,
+// the vine, by rotating right every node with a left child, and the
+// vine is then folded into a balanced Tree by rounds of left rotations
+// on every other node of the right spine. It runs in O(n), reusing
+// the existing nodes without allocating new ones.
+func (t *Tree) Rebalance() {
+	// turn the Tree into a vine
+	count := 0
+	current := t.root
+	for current != nil {
+		if current.left != nil {
+			t.rotateRight(current)
+			current = current.parent
+			continue
+		}
+		count++
+		current = current.right
+	}
+
+	// the nodes exceeding the largest full tree that fits in the
+	// Tree go to the last level, then each round halves the vine
+	full := 1<<(bits.Len(uint(count+1))-1) - 1
+	t.compress(count - full)
+	for full > 1 {
+		full /= 2
+		t.compress(full)
+	}
+}
+
+// buildBalanced links nodes, sorted by key, into a balanced
+// subtree hanging from parent and returns its root.
+func (t *Tree) buildBalanced(nodes []*Node, parent *Node) *Node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	middle := len(nodes) / 2
+	root := nodes[middle]
+	root.parent = parent
+	root.left = t.buildBalanced(nodes[:middle], root)
+	root.right = t.buildBalanced(nodes[middle+1:], root)
+	root.size = root.Count() + size(root.left) + size(root.right)
+	return root
+}
+
+// compress rotates left count nodes of the right spine, starting at
+// the root and skipping one node each time, so each rotated node
+// becomes the left child of the one that followed it.
+func (t *Tree) compress(count int) {
+	current := t.root
+	for range count {
+		t.rotateLeft(current)
+		current = current.parent.right
+	}
+}
+
+// rotateLeft moves the right child of node up to its place,
+// making node its left child, and keeps the subtree sizes.
+func (t *Tree) rotateLeft(node *Node) {
+	pivot := node.right
+	node.right = pivot.left
+	if pivot.left != nil {
+		pivot.left.parent = node
+	}
+	t.transplant(node, pivot)
+	pivot.left = node
+	node.parent = pivot
+
+	pivot.size = node.size
+	node.size = node.Count() + size(node.left) + size(node.right)
+}
+
+// rotateRight moves the left child of node up to its place,
+// making node its right child, and keeps the subtree sizes.
+func (t *Tree) rotateRight(node *Node) {
+	pivot := node.left
+	node.left = pivot.right
+	if pivot.right != nil {
+		pivot.right.parent = node
+	}
+	t.transplant(node, pivot)
+	pivot.right = node
+	node.parent = pivot
+
+	pivot.size = node.size
+	node.size = node.Count() + size(node.left) + size(node.right)
+}
@@ -0,0 +1,165 @@
+package binary_test
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTreeFromSorted(t *testing.T) {
+	testCases := map[string]struct {
+		keys             []int
+		options          []binary.Option
+		expectedErr      error
+		expectedPreOrder string
+		expectedLevels   [][]int
+	}{
+		"odd number of elements": {
+			keys:             []int{1, 2, 3, 4, 5, 6, 7},
+			expectedPreOrder: "[4] [2] [1] [3] [6] [5] [7] ",
+			expectedLevels:   [][]int{{4}, {2, 6}, {1, 3, 5, 7}},
+		},
+		"even number of elements": {
+			keys:             []int{1, 2, 3, 4, 5, 6},
+			expectedPreOrder: "[4] [2] [1] [3] [6] [5] ",
+			expectedLevels:   [][]int{{4}, {2, 6}, {1, 3, 5}},
+		},
+		"duplicated elements with multiset": {
+			keys:             []int{1, 2, 2, 3, 3, 3},
+			expectedPreOrder: "[2] [2] [1] [3] [3] [3] ",
+			expectedLevels:   [][]int{{2}, {1, 3}},
+		},
+		"duplicated elements with replace": {
+			keys:             []int{1, 2, 2, 3},
+			options:          []binary.Option{binary.WithDuplicatePolicy(binary.Replace)},
+			expectedPreOrder: "[2] [1] [3] ",
+			expectedLevels:   [][]int{{2}, {1, 3}},
+		},
+		"duplicated elements with reject": {
+			keys:        []int{1, 2, 2, 3},
+			options:     []binary.Option{binary.WithDuplicatePolicy(binary.Reject)},
+			expectedErr: binary.ErrDuplicateKey,
+		},
+		"unsorted elements": {
+			keys:        []int{1, 3, 2},
+			expectedErr: binary.ErrNotSorted,
+		},
+		"no elements": {
+			keys:             []int{},
+			expectedPreOrder: "",
+			expectedLevels:   nil,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			elements := make([]element.GetterSetter, len(tc.keys))
+			for i, key := range tc.keys {
+				elements[i] = item{key: key}
+			}
+
+			bst, err := binary.NewTreeFromSorted(elements, tc.options...)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, bst)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrder))
+			assert.Equal(t, tc.expectedLevels, bst.Levels())
+			for i := range bst.Len() {
+				node := bst.Select(i)
+				rank := bst.Rank(node.Element().GetKey())
+				assert.LessOrEqual(t, rank, i)
+				assert.Greater(t, rank+node.Count(), i)
+			}
+		})
+	}
+}
+
+func TestBinaryTreeRebalance(t *testing.T) {
+	testCases := map[string]struct {
+		keys           []int
+		expectedLevels [][]int
+	}{
+		"ascending chain": {
+			keys:           []int{1, 2, 3, 4, 5, 6, 7},
+			expectedLevels: [][]int{{4}, {2, 6}, {1, 3, 5, 7}},
+		},
+		"descending chain": {
+			keys:           []int{7, 6, 5, 4, 3, 2, 1},
+			expectedLevels: [][]int{{4}, {2, 6}, {1, 3, 5, 7}},
+		},
+		"partial last level": {
+			keys:           []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedLevels: [][]int{{7}, {4, 9}, {2, 6, 8, 10}, {1, 3, 5}},
+		},
+		"random elements": {
+			keys:           []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedLevels: [][]int{{8}, {6, 13}, {3, 7, 10, 14}, {1, 4}},
+		},
+		"single element": {
+			keys:           []int{1},
+			expectedLevels: [][]int{{1}},
+		},
+		"no elements": {
+			keys:           []int{},
+			expectedLevels: nil,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+			inOrder := bst.Traverse(binary.InOrder)
+
+			bst.Rebalance()
+
+			assert.Equal(t, tc.expectedLevels, bst.Levels(), bst.PrettyString())
+			assert.Equal(t, inOrder, bst.Traverse(binary.InOrder))
+			assert.Equal(t, len(tc.keys), bst.Len())
+			for _, key := range tc.keys {
+				assert.NotNil(t, bst.Search(key))
+				assert.Equal(t, key, bst.Select(bst.Rank(key)).Element().GetKey())
+			}
+		})
+	}
+}
+
+func TestBinaryTreeRebalanceLarge(t *testing.T) {
+	const n = 10_000
+
+	bst := binary.NewTree()
+	for key := range n {
+		bst.Insert(item{key: key})
+		bst.Insert(item{key: key})
+	}
+	bst.Rebalance()
+
+	assert.Len(t, bst.Levels(), bits.Len(n))
+	assert.Equal(t, 2*n, bst.Len())
+	for key := 0; key < n; key += 100 {
+		assert.Equal(t, 2*key, bst.Rank(key))
+	}
+
+	var walked int
+	for node := bst.Min(); node != nil; node = bst.Successor(node) {
+		assert.Equal(t, walked, node.Element().GetKey())
+		walked++
+	}
+	assert.Equal(t, n, walked)
+}
@@ -3,63 +3,159 @@
 package binary
 
 import (
+	"errors"
 	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
 	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/queue"
+	"github.com/felipebool/dsa/ds/stack"
 )
 
 const (
 	InOrder TraverseAlgorithm = iota
 	PreOrder
 	PostOrder
+	LevelOrder
+	ZigZag
+	InOrderIterative
+	PreOrderIterative
+	PostOrderIterative
+	MorrisInOrder
 )
 
 type TraverseAlgorithm int
 
+const (
+	Multiset DuplicatePolicy = iota
+	Reject
+	Replace
+)
+
+// DuplicatePolicy tells what Insert does with an element whose
+// key is already in the Tree,
+// 0 (Multiset) keeps both, in the same Node
+// 1 (Reject) returns ErrDuplicateKey
+// 2 (Replace) replaces the element in the Tree
+// Whatever the policy, there is at most one Node per key.
+type DuplicatePolicy int
+
+// ErrDuplicateKey is returned by Insert when the key of
+// the element is already in a Tree with the Reject policy.
+var ErrDuplicateKey = errors.New("binary: duplicate key")
+
+// Option configures a Tree created by NewTree.
+type Option func(*Tree)
+
+// WithDuplicatePolicy sets the DuplicatePolicy of the
+// Tree, which is Multiset by default.
+func WithDuplicatePolicy(policy DuplicatePolicy) Option {
+	return func(t *Tree) {
+		t.policy = policy
+	}
+}
+
 // Node represents a node in a Tree. Each node has an
 // element which implements the Element interface
-// (GetKey() int) and two other Nodes. The Node's key
-// must be greater than all the keys on the left subtree
-// and smaller than all the keys on the right subtree.
+// (GetKey() int), the elements inserted later with the
+// same key, for Trees with the Multiset policy, two other
+// Nodes and the number of elements in the subtree rooted
+// at it. The Node's key must be greater than all the keys
+// on the left subtree and smaller than all the keys on
+// the right subtree.
 type Node struct {
-	element element.GetterSetter
-	parent  *Node
-	left    *Node
-	right   *Node
+	element    element.GetterSetter
+	duplicates []element.GetterSetter
+	parent     *Node
+	left       *Node
+	right      *Node
+	size       int
+}
+
+// Element returns the element stored in the Node, the
+// first one inserted if the Node holds duplicates.
+func (n *Node) Element() element.GetterSetter {
+	return n.element
+}
+
+// Elements returns every element stored in the
+// Node, in the order they were inserted.
+func (n *Node) Elements() []element.GetterSetter {
+	return append([]element.GetterSetter{n.element}, n.duplicates...)
+}
+
+// Count returns the number of elements stored in the Node,
+// which is more than one only for duplicated keys in a
+// Tree with the Multiset policy.
+func (n *Node) Count() int {
+	return 1 + len(n.duplicates)
 }
 
 type Tree struct {
-	root *Node
+	root   *Node
+	policy DuplicatePolicy
+	codec  Codec
 }
 
-func (t *Tree) Insert(element element.GetterSetter) {
+// Insert adds element to the Tree. If its key is already in
+// the Tree, the outcome depends on the DuplicatePolicy: with
+// Multiset, element is added to the existing Node, with Replace,
+// it takes the place of the element in that Node, and with
+// Reject, the Tree is left untouched and ErrDuplicateKey
+// is returned.
+func (t *Tree) Insert(element element.GetterSetter) error {
+	key := element.GetKey()
 	if t.root == nil {
-		t.root = &Node{element: element}
-		return
+		t.root = &Node{element: element, size: 1}
+		return nil
 	}
 
 	var previous *Node
 	current := t.root
 	for current != nil {
 		previous = current
-		if element.GetKey() < current.element.GetKey() {
+		if key < current.element.GetKey() {
 			current = current.left
 			continue
 		}
-		current = current.right
-	}
+		if key > current.element.GetKey() {
+			current = current.right
+			continue
+		}
 
-	newNode := &Node{element: element}
-	if previous == nil {
-		t.root = newNode
-		return
+		switch t.policy {
+		case Reject:
+			return ErrDuplicateKey
+		case Replace:
+			current.element = element
+		default:
+			current.duplicates = append(current.duplicates, element)
+			t.updateSizes(current)
+		}
+		return nil
 	}
 
+	newNode := &Node{element: element, size: 1}
 	newNode.parent = previous
-	if newNode.element.GetKey() < previous.element.GetKey() {
+	if key < previous.element.GetKey() {
 		previous.left = newNode
-		return
+	} else {
+		previous.right = newNode
+	}
+	t.updateSizes(previous)
+	return nil
+}
+
+// SearchAll returns every element with key, in the order they
+// were inserted, or nil if there is none.
+func (t *Tree) SearchAll(key int) []element.GetterSetter {
+	node := t.Search(key)
+	if node == nil {
+		return nil
 	}
-	previous.right = newNode
+	return node.Elements()
 }
 
 func (t *Tree) Search(key int) *Node {
@@ -78,87 +174,685 @@ func (t *Tree) Search(key int) *Node {
 	return nil
 }
 
+// Floor returns the Node holding the largest key smaller than
+// or equal to key, or nil if every key in the Tree is larger.
+func (t *Tree) Floor(key int) *Node {
+	var floor *Node
+	current := t.root
+	for current != nil {
+		if current.element.GetKey() > key {
+			current = current.left
+			continue
+		}
+		floor = current
+		current = current.right
+	}
+	return floor
+}
+
+// Ceiling returns the Node holding the smallest key larger than
+// or equal to key, or nil if every key in the Tree is smaller.
+func (t *Tree) Ceiling(key int) *Node {
+	var ceiling *Node
+	current := t.root
+	for current != nil {
+		if current.element.GetKey() < key {
+			current = current.right
+			continue
+		}
+		ceiling = current
+		current = current.left
+	}
+	return ceiling
+}
+
+// Min returns the Node holding the smallest key,
+// or nil if the Tree is empty.
+func (t *Tree) Min() *Node {
+	return t.leftMost(t.root)
+}
+
+// Max returns the Node holding the largest key,
+// or nil if the Tree is empty.
+func (t *Tree) Max() *Node {
+	return t.rightMost(t.root)
+}
+
+// Predecessor returns the Node that comes right before node
+// in an in-order traversal, or nil if node holds the smallest
+// key. It follows the parent pointers, so no key comparison is
+// needed and node must belong to the Tree.
+func (t *Tree) Predecessor(node *Node) *Node {
+	if node == nil {
+		return nil
+	}
+	if node.left != nil {
+		return t.rightMost(node.left)
+	}
+
+	// the predecessor is the first ancestor
+	// reached from its right subtree
+	current := node
+	for current.parent != nil && current == current.parent.left {
+		current = current.parent
+	}
+	return current.parent
+}
+
+// Successor returns the Node that comes right after node in an
+// in-order traversal, or nil if node holds the largest key. It
+// follows the parent pointers, so no key comparison is needed
+// and node must belong to the Tree.
+func (t *Tree) Successor(node *Node) *Node {
+	if node == nil {
+		return nil
+	}
+	if node.right != nil {
+		return t.leftMost(node.right)
+	}
+
+	// the successor is the first ancestor
+	// reached from its left subtree
+	current := node
+	for current.parent != nil && current == current.parent.right {
+		current = current.parent
+	}
+	return current.parent
+}
+
+// Remove removes an element with key from the Tree, the last
+// one inserted if there are duplicates. The Node holding key is
+// removed along with its last element.
 func (t *Tree) Remove(key int) {
 	node := t.Search(key)
 	if node == nil {
 		return
 	}
-
-	// we found the key in the root node
-	if node.parent == nil {
-		t.root = t.removeNode(node)
+	if len(node.duplicates) > 0 {
+		last := len(node.duplicates) - 1
+		node.duplicates[last] = nil
+		node.duplicates = node.duplicates[:last]
+		t.updateSizes(node)
 		return
 	}
+	t.removeNode(node)
+}
 
-	if node.element.GetKey() < node.parent.element.GetKey() {
-		node.parent.left = t.removeNode(node)
-		return
+// Select returns the Node holding the k-th smallest element in
+// the Tree, counting from 0, so Select(0) is the Node with the
+// smallest key. Duplicates count as different elements, so with
+// the Multiset policy consecutive values of k may give the same
+// Node. It returns nil if k is out of range. It runs in O(height).
+func (t *Tree) Select(k int) *Node {
+	current := t.root
+	for current != nil {
+		left := size(current.left)
+		if k < left {
+			current = current.left
+			continue
+		}
+		if k < left+current.Count() {
+			return current
+		}
+		k -= left + current.Count()
+		current = current.right
 	}
-	node.parent.right = t.removeNode(node)
+	return nil
 }
 
-func (t *Tree) removeNode(node *Node) *Node {
-	if node.right == nil {
-		root := node.left
-		node.left = nil
-		return root
+// Rank returns the number of elements in the Tree with keys
+// smaller than key, which is also the position Select would find
+// key at if it is in the Tree. It runs in O(height).
+func (t *Tree) Rank(key int) int {
+	rank := 0
+	current := t.root
+	for current != nil {
+		if key <= current.element.GetKey() {
+			current = current.left
+			continue
+		}
+		rank += size(current.left) + current.Count()
+		current = current.right
 	}
+	return rank
+}
 
-	successor := t.leftMost(node.right)
+// Range returns an iterator over the elements with keys in
+// [lo, hi], in ascending order. It finds the first one with
+// Ceiling and then walks through the following ones with
+// Successor, so iterating k elements takes O(height + k).
+// The Tree must not be modified while it is being iterated.
+func (t *Tree) Range(lo, hi int) iter.Seq[element.GetterSetter] {
+	return func(yield func(element.GetterSetter) bool) {
+		for node := t.Ceiling(lo); node != nil; node = t.Successor(node) {
+			if node.element.GetKey() > hi {
+				return
+			}
+			if !yieldAll(node, yield) {
+				return
+			}
+		}
+	}
+}
 
-	// if the successor is not the right node
-	if successor.parent.element.GetKey() != node.element.GetKey() {
-		successor.parent.left = successor.right
-		successor.right = node.right
+// CountRange returns the number of elements with keys in
+// [lo, hi], in O(height), using the subtree sizes.
+func (t *Tree) CountRange(lo, hi int) int {
+	if lo > hi {
+		return 0
+	}
+	return t.countUpTo(hi) - t.Rank(lo)
+}
+
+// DeleteRange removes every element with key in [lo, hi] and
+// returns how many were removed. Instead of calling Remove for
+// each key, it finds the highest node in the range, which has
+// every other one in its subtree, and cuts the range out of its
+// left and right subtrees, dropping whole subtrees at once, so
+// it runs in O(height) whatever the number of removed elements.
+// Nodes holding removed elements must not be used afterwards.
+func (t *Tree) DeleteRange(lo, hi int) int {
+	if lo > hi {
+		return 0
 	}
 
-	successor.left = node.left
+	// find the highest node in the range
+	node := t.root
+	for node != nil {
+		key := node.element.GetKey()
+		if key < lo {
+			node = node.right
+			continue
+		}
+		if key > hi {
+			node = node.left
+			continue
+		}
+		break
+	}
+	if node == nil {
+		return 0
+	}
+	removed := node.size
+
+	// the left subtree keeps the keys smaller than lo and the right
+	// subtree the keys larger than hi, and as every key on the left is
+	// smaller than the ones on the right, the right one can be hung
+	// from the largest node on the left
+	left, leftLast := t.keepBelow(node.left, lo)
+	right, rightLast := t.keepAbove(node.right, hi)
+	joined, last := left, leftLast
+	switch {
+	case left == nil:
+		joined, last = right, rightLast
+	case right != nil:
+		leftLast.right = right
+		right.parent = leftLast
+		last = rightLast
+	}
+
+	t.transplant(node, joined)
+	if last == nil {
+		last = node.parent
+	}
+	t.updateSizes(last)
+
+	if joined != nil {
+		removed -= joined.size
+	}
+	node.parent = nil
 	node.left = nil
 	node.right = nil
-	return successor
+	return removed
+}
+
+// keepBelow removes from the subtree rooted at root every node
+// with key larger than or equal to lo, assuming all its keys are
+// in range on that side, that is, smaller than or equal to hi.
+// The kept nodes form a chain of right children, each one keeping
+// its left subtree, and keepBelow returns the new root of the
+// subtree and the last node of that chain, whose subtree sizes
+// are stale and must be updated by the caller.
+func (t *Tree) keepBelow(root *Node, lo int) (*Node, *Node) {
+	var newRoot, last *Node
+	link := &newRoot
+	for current := root; current != nil; {
+		if current.element.GetKey() >= lo {
+			// current and its right subtree are in the range
+			current = current.left
+			continue
+		}
+		*link = current
+		current.parent = last
+		last = current
+		link = &current.right
+		current = current.right
+	}
+	*link = nil
+	return newRoot, last
+}
+
+// keepAbove is the mirror of keepBelow, removing from the subtree
+// rooted at root every node with key smaller than or equal to hi.
+func (t *Tree) keepAbove(root *Node, hi int) (*Node, *Node) {
+	var newRoot, last *Node
+	link := &newRoot
+	for current := root; current != nil; {
+		if current.element.GetKey() <= hi {
+			// current and its left subtree are in the range
+			current = current.right
+			continue
+		}
+		*link = current
+		current.parent = last
+		last = current
+		link = &current.left
+		current = current.left
+	}
+	*link = nil
+	return newRoot, last
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return size(t.root)
+}
+
+// countUpTo returns the number of elements in the
+// Tree with keys smaller than or equal to key.
+func (t *Tree) countUpTo(key int) int {
+	count := 0
+	current := t.root
+	for current != nil {
+		if key < current.element.GetKey() {
+			current = current.left
+			continue
+		}
+		count += size(current.left) + current.Count()
+		current = current.right
+	}
+	return count
 }
 
+// removeNode unlinks node from the Tree, replacing it by one of
+// its children or, if it has both, by its in-order successor.
+func (t *Tree) removeNode(node *Node) {
+	// lowest node whose subtree lost a node
+	var start *Node
+	switch {
+	case node.left == nil:
+		start = node.parent
+		t.transplant(node, node.right)
+	case node.right == nil:
+		start = node.parent
+		t.transplant(node, node.left)
+	default:
+		successor := t.leftMost(node.right)
+		start = successor
+
+		// if the successor is not the right node
+		if successor.parent != node {
+			start = successor.parent
+			t.transplant(successor, successor.right)
+			successor.right = node.right
+			successor.right.parent = successor
+		}
+
+		t.transplant(node, successor)
+		successor.left = node.left
+		successor.left.parent = successor
+	}
+
+	node.parent = nil
+	node.left = nil
+	node.right = nil
+	t.updateSizes(start)
+}
+
+// transplant replaces the subtree rooted at old by
+// the one rooted at new in old's parent.
+func (t *Tree) transplant(old, new *Node) {
+	switch {
+	case old.parent == nil:
+		t.root = new
+	case old == old.parent.left:
+		old.parent.left = new
+	default:
+		old.parent.right = new
+	}
+	if new != nil {
+		new.parent = old.parent
+	}
+}
+
+// updateSizes recomputes the subtree sizes from node up to the root.
+func (t *Tree) updateSizes(node *Node) {
+	for current := node; current != nil; current = current.parent {
+		current.size = current.Count() + size(current.left) + size(current.right)
+	}
+}
+
+// Traverse returns the keys in the Tree, in the order given
+// by algorithm, formatted as "[key] " one after the other.
+// InOrder, PreOrder and PostOrder are recursive, while their
+// Iterative counterparts keep the pending nodes in a stack.Stack,
+// so they are not bound by the goroutine stack on degenerate trees.
+// MorrisInOrder needs no stack at all: it temporarily links each
+// node to its in-order successor, so the Tree must not be read
+// concurrently while it runs.
 func (t *Tree) Traverse(algorithm TraverseAlgorithm) string {
+	var result strings.Builder
 	switch algorithm {
 	case InOrder:
-		return t.inOrder(t.root)
+		t.inOrder(t.root, &result)
 	case PreOrder:
-		return t.preOrder(t.root)
+		t.preOrder(t.root, &result)
 	case PostOrder:
-		return t.postOrder(t.root)
+		t.postOrder(t.root, &result)
+	case LevelOrder:
+		t.levelOrder(false, &result)
+	case ZigZag:
+		t.levelOrder(true, &result)
+	case InOrderIterative:
+		t.inOrderIterative(&result)
+	case PreOrderIterative:
+		t.preOrderIterative(&result)
+	case PostOrderIterative:
+		t.postOrderIterative(&result)
+	case MorrisInOrder:
+		t.morrisInOrder(&result)
 	default:
 		return "unknown traversal algorithm"
 	}
+	return result.String()
+}
+
+// Levels returns the keys in the Tree grouped by depth, the
+// root's key first, then the keys of its children, and so on,
+// each level from left to right. Each Node contributes its key
+// once, even if it holds duplicates. It returns nil if the Tree
+// is empty.
+func (t *Tree) Levels() [][]int {
+	var result [][]int
+	t.levels(func(level []*Node) bool {
+		keys := make([]int, len(level))
+		for i, node := range level {
+			keys[i] = node.element.GetKey()
+		}
+		result = append(result, keys)
+		return true
+	})
+	return result
+}
+
+// All returns an iterator over the elements of the Tree in the
+// order given by algorithm, visiting nodes with an explicit stack
+// (or queue, for LevelOrder and ZigZag) instead of recursion, so
+// degenerate trees do not grow the goroutine stack. The
+// Iterative variants and MorrisInOrder visit the elements in
+// the same order as the algorithm they implement. Breaking
+// out of the loop stops the traversal. The iterator yields
+// nothing for an unknown algorithm, and the Tree must not be
+// modified while it is being iterated.
+func (t *Tree) All(algorithm TraverseAlgorithm) iter.Seq[element.GetterSetter] {
+	return func(yield func(element.GetterSetter) bool) {
+		switch algorithm {
+		case InOrder, InOrderIterative, MorrisInOrder:
+			t.allInOrder(yield)
+		case PreOrder, PreOrderIterative:
+			t.allPreOrder(yield)
+		case PostOrder, PostOrderIterative:
+			t.allPostOrder(yield)
+		case LevelOrder:
+			t.allLevelOrder(yield, false)
+		case ZigZag:
+			t.allLevelOrder(yield, true)
+		}
+	}
+}
+
+func (t *Tree) allInOrder(yield func(element.GetterSetter) bool) {
+	var stack []*Node
+	current := t.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.left
+		}
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !yieldAll(current, yield) {
+			return
+		}
+		current = current.right
+	}
+}
+
+func (t *Tree) allPreOrder(yield func(element.GetterSetter) bool) {
+	if t.root == nil {
+		return
+	}
+	stack := []*Node{t.root}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !yieldAll(current, yield) {
+			return
+		}
+		// right is pushed first so left is visited first
+		if current.right != nil {
+			stack = append(stack, current.right)
+		}
+		if current.left != nil {
+			stack = append(stack, current.left)
+		}
+	}
+}
+
+func (t *Tree) allPostOrder(yield func(element.GetterSetter) bool) {
+	var stack []*Node
+	var last *Node
+	current := t.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.left
+		}
+		top := stack[len(stack)-1]
+
+		// the right subtree is visited before the node itself,
+		// last tells whether we are coming back from it
+		if top.right != nil && top.right != last {
+			current = top.right
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if !yieldAll(top, yield) {
+			return
+		}
+		last = top
+	}
+}
+
+func (t *Tree) allLevelOrder(yield func(element.GetterSetter) bool, zigZag bool) {
+	depth := 0
+	t.levels(func(level []*Node) bool {
+		if zigZag && depth%2 == 1 {
+			slices.Reverse(level)
+		}
+		depth++
+		for _, node := range level {
+			if !yieldAll(node, yield) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// levels visits the Tree breadth-first, calling visit with the
+// nodes of each level, from left to right, until it returns
+// false. The nodes waiting to be visited are kept in a
+// queue.Queue, and the number of nodes in a level is the length
+// of the queue when the previous level is done.
+func (t *Tree) levels(visit func(level []*Node) bool) {
+	if t.root == nil {
+		return
+	}
+
+	q := queue.NewQueue()
+	q.Enqueue(t.root)
+	for !q.IsEmpty() {
+		level := make([]*Node, q.Len())
+		for i := range level {
+			node := q.Dequeue().(*Node)
+			level[i] = node
+			if node.left != nil {
+				q.Enqueue(node.left)
+			}
+			if node.right != nil {
+				q.Enqueue(node.right)
+			}
+		}
+		if !visit(level) {
+			return
+		}
+	}
+}
+
+// levelOrder writes the keys in breadth-first order, reversing
+// every other level, starting at the root's children, if zigZag
+// is true.
+func (t *Tree) levelOrder(zigZag bool, result *strings.Builder) {
+	depth := 0
+	t.levels(func(level []*Node) bool {
+		if zigZag && depth%2 == 1 {
+			slices.Reverse(level)
+		}
+		for _, node := range level {
+			t.writeKeys(node, result)
+		}
+		depth++
+		return true
+	})
 }
 
-func (t *Tree) inOrder(root *Node) string {
+func (t *Tree) inOrder(root *Node, result *strings.Builder) {
 	if root == nil {
-		return ""
+		return
 	}
-	result := t.inOrder(root.left)
-	result += fmt.Sprintf("[%d] ", root.element.GetKey())
-	result += t.inOrder(root.right)
-	return result
+	t.inOrder(root.left, result)
+	t.writeKeys(root, result)
+	t.inOrder(root.right, result)
 }
 
-func (t *Tree) preOrder(root *Node) string {
+func (t *Tree) preOrder(root *Node, result *strings.Builder) {
 	if root == nil {
-		return ""
+		return
 	}
-	result := fmt.Sprintf("[%d] ", root.element.GetKey())
-	result += t.preOrder(root.left)
-	result += t.preOrder(root.right)
-	return result
+	t.writeKeys(root, result)
+	t.preOrder(root.left, result)
+	t.preOrder(root.right, result)
 }
 
-func (t *Tree) postOrder(root *Node) string {
+func (t *Tree) postOrder(root *Node, result *strings.Builder) {
 	if root == nil {
-		return ""
+		return
+	}
+	t.postOrder(root.left, result)
+	t.postOrder(root.right, result)
+	t.writeKeys(root, result)
+}
+
+func (t *Tree) inOrderIterative(result *strings.Builder) {
+	s := stack.NewStack()
+	current := t.root
+	for current != nil || !s.IsEmpty() {
+		// go as far left as possible, the nodes
+		// in the way are visited on the way back
+		for current != nil {
+			s.Push(current)
+			current = current.left
+		}
+		current = s.Pop().(*Node)
+		t.writeKeys(current, result)
+		current = current.right
+	}
+}
+
+func (t *Tree) preOrderIterative(result *strings.Builder) {
+	if t.root == nil {
+		return
+	}
+	s := stack.NewStack()
+	s.Push(t.root)
+	for !s.IsEmpty() {
+		current := s.Pop().(*Node)
+		t.writeKeys(current, result)
+		// right is pushed first so left is visited first
+		if current.right != nil {
+			s.Push(current.right)
+		}
+		if current.left != nil {
+			s.Push(current.left)
+		}
+	}
+}
+
+func (t *Tree) postOrderIterative(result *strings.Builder) {
+	s := stack.NewStack()
+	var last *Node
+	current := t.root
+	for current != nil || !s.IsEmpty() {
+		for current != nil {
+			s.Push(current)
+			current = current.left
+		}
+		top := s.Peek().(*Node)
+
+		// the right subtree is visited before the node itself,
+		// last tells whether we are coming back from it
+		if top.right != nil && top.right != last {
+			current = top.right
+			continue
+		}
+		s.Pop()
+		t.writeKeys(top, result)
+		last = top
+	}
+}
+
+// morrisInOrder visits the nodes in order using O(1) extra space.
+// Before descending into the left subtree of a node, it points the
+// right child of the node's predecessor, which is nil, back to the
+// node, so it can climb up again once the left subtree is done,
+// and removes the link when it comes across it the second time.
+func (t *Tree) morrisInOrder(result *strings.Builder) {
+	current := t.root
+	for current != nil {
+		if current.left == nil {
+			t.writeKeys(current, result)
+			current = current.right
+			continue
+		}
+
+		predecessor := current.left
+		for predecessor.right != nil && predecessor.right != current {
+			predecessor = predecessor.right
+		}
+
+		// first time here, link the predecessor and go left
+		if predecessor.right == nil {
+			predecessor.right = current
+			current = current.left
+			continue
+		}
+
+		// back from the left subtree, unlink the predecessor
+		predecessor.right = nil
+		t.writeKeys(current, result)
+		current = current.right
 	}
-	result := t.postOrder(root.left)
-	result += t.postOrder(root.right)
-	result += fmt.Sprintf("[%d] ", root.element.GetKey())
-	return result
 }
 
 func (t *Tree) leftMost(node *Node) *Node {
@@ -172,6 +866,52 @@ func (t *Tree) leftMost(node *Node) *Node {
 	return current
 }
 
-func NewTree() *Tree {
-	return &Tree{}
+func (t *Tree) rightMost(node *Node) *Node {
+	if node == nil {
+		return nil
+	}
+	current := node
+	for current.right != nil {
+		current = current.right
+	}
+	return current
+}
+
+// writeKeys writes the key of node as printed by
+// Traverse, once for every element it holds.
+func (t *Tree) writeKeys(node *Node, result *strings.Builder) {
+	for range node.Count() {
+		fmt.Fprintf(result, "[%d] ", node.element.GetKey())
+	}
+}
+
+// yieldAll yields every element in node, returning
+// false as soon as yield does.
+func yieldAll(node *Node, yield func(element.GetterSetter) bool) bool {
+	if !yield(node.element) {
+		return false
+	}
+	for _, el := range node.duplicates {
+		if !yield(el) {
+			return false
+		}
+	}
+	return true
+}
+
+func size(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// NewTree returns a new Tree with no elements, configured
+// by opts.
+func NewTree(opts ...Option) *Tree {
+	t := &Tree{}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
 }
@@ -1,10 +1,13 @@
 package binary_test
 
 import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
 	"github.com/felipebool/dsa/ds/element"
 	"github.com/felipebool/dsa/ds/tree/binary"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 type item struct {
@@ -19,6 +22,19 @@ func (e item) SetKey(key int) {
 	e.key = key
 }
 
+type namedItem struct {
+	key  int
+	name string
+}
+
+func (e namedItem) GetKey() int {
+	return e.key
+}
+
+func (e namedItem) SetKey(key int) {
+	e.key = key
+}
+
 func TestBinaryTreeInsertion(t *testing.T) {
 	testCases := map[string]struct {
 		elements          []element.GetterSetter
@@ -57,6 +73,11 @@ func TestBinaryTreeInsertion(t *testing.T) {
 			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.InOrder))
 			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrder))
 			assert.Equal(t, tc.expectedPostOrder, bst.Traverse(binary.PostOrder))
+
+			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.MorrisInOrder))
+			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.InOrderIterative))
+			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrderIterative))
+			assert.Equal(t, tc.expectedPostOrder, bst.Traverse(binary.PostOrderIterative))
 		})
 	}
 }
@@ -168,9 +189,674 @@ func TestBinaryTreeRemove(t *testing.T) {
 
 			bst.Remove(tc.elementToRemove.GetKey())
 
+			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.InOrder), bst.PrettyString())
+			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrder), bst.PrettyString())
+			assert.Equal(t, tc.expectedPostOrder, bst.Traverse(binary.PostOrder), bst.PrettyString())
+
+			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.MorrisInOrder))
+			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.InOrderIterative))
+			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrderIterative))
+			assert.Equal(t, tc.expectedPostOrder, bst.Traverse(binary.PostOrderIterative))
+		})
+	}
+}
+
+func TestBinaryTreeAll(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+
+	testCases := map[string]struct {
+		algorithm    binary.TraverseAlgorithm
+		limit        int
+		expectedKeys []int
+	}{
+		"in order": {
+			algorithm:    binary.InOrder,
+			expectedKeys: []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+		},
+		"pre order": {
+			algorithm:    binary.PreOrder,
+			expectedKeys: []int{8, 3, 1, 6, 4, 7, 10, 14, 13},
+		},
+		"post order": {
+			algorithm:    binary.PostOrder,
+			expectedKeys: []int{1, 4, 7, 6, 3, 13, 14, 10, 8},
+		},
+		"level order": {
+			algorithm:    binary.LevelOrder,
+			expectedKeys: []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+		},
+		"in order iterative": {
+			algorithm:    binary.InOrderIterative,
+			expectedKeys: []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+		},
+		"pre order iterative": {
+			algorithm:    binary.PreOrderIterative,
+			expectedKeys: []int{8, 3, 1, 6, 4, 7, 10, 14, 13},
+		},
+		"post order iterative": {
+			algorithm:    binary.PostOrderIterative,
+			expectedKeys: []int{1, 4, 7, 6, 3, 13, 14, 10, 8},
+		},
+		"morris in order": {
+			algorithm:    binary.MorrisInOrder,
+			expectedKeys: []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+		},
+		"in order with early break": {
+			algorithm:    binary.InOrder,
+			limit:        4,
+			expectedKeys: []int{1, 3, 4, 6},
+		},
+		"post order with early break": {
+			algorithm:    binary.PostOrder,
+			limit:        3,
+			expectedKeys: []int{1, 4, 7},
+		},
+		"unknown algorithm": {
+			algorithm:    binary.TraverseAlgorithm(-1),
+			expectedKeys: nil,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, i := range elements {
+				bst.Insert(i)
+			}
+
+			var keys []int
+			for el := range bst.All(tc.algorithm) {
+				keys = append(keys, el.GetKey())
+				if len(keys) == tc.limit {
+					break
+				}
+			}
+			assert.Equal(t, tc.expectedKeys, keys)
+
+			for range binary.NewTree().All(tc.algorithm) {
+				assert.Fail(t, "empty tree must yield nothing")
+			}
+		})
+	}
+}
+
+func TestBinaryTreeAllDegenerate(t *testing.T) {
+	const n = 10_000
+
+	bst := binary.NewTree()
+	for key := n; key > 0; key-- {
+		bst.Insert(item{key: key})
+	}
+
+	for _, algorithm := range []binary.TraverseAlgorithm{
+		binary.InOrder,
+		binary.PreOrder,
+		binary.PostOrder,
+		binary.LevelOrder,
+	} {
+		count := 0
+		for range bst.All(algorithm) {
+			count++
+		}
+		assert.Equal(t, n, count)
+	}
+}
+
+func TestBinaryTreeOrderStatistics(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+
+	testCases := map[string]struct {
+		keysToRemove []int
+		expectedKeys []int
+	}{
+		"no removal": {
+			expectedKeys: []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+		},
+		"removing leaf node": {
+			keysToRemove: []int{4},
+			expectedKeys: []int{1, 3, 6, 7, 8, 10, 13, 14},
+		},
+		"removing nodes with two children": {
+			keysToRemove: []int{3, 6},
+			expectedKeys: []int{1, 4, 7, 8, 10, 13, 14},
+		},
+		"removing node with only right child": {
+			keysToRemove: []int{10},
+			expectedKeys: []int{1, 3, 4, 6, 7, 8, 13, 14},
+		},
+		"removing root node twice": {
+			keysToRemove: []int{8, 10},
+			expectedKeys: []int{1, 3, 4, 6, 7, 13, 14},
+		},
+		"removing everything": {
+			keysToRemove: []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedKeys: []int{},
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, i := range elements {
+				bst.Insert(i)
+			}
+			for _, key := range tc.keysToRemove {
+				bst.Remove(key)
+			}
+
+			assert.Equal(t, len(tc.expectedKeys), bst.Len())
+			for i, key := range tc.expectedKeys {
+				node := bst.Select(i)
+				if assert.NotNil(t, node) {
+					assert.Equal(t, key, node.Element().GetKey())
+				}
+				assert.Equal(t, i, bst.Rank(key))
+				assert.Equal(t, i+1, bst.Rank(key+1))
+			}
+			assert.Nil(t, bst.Select(-1))
+			assert.Nil(t, bst.Select(len(tc.expectedKeys)))
+			assert.Equal(t, 0, bst.Rank(0))
+			assert.Equal(t, len(tc.expectedKeys), bst.Rank(100))
+		})
+	}
+}
+
+func TestBinaryTreeNeighbors(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+
+	testCases := map[string]struct {
+		key                 int
+		expectedFloor       any
+		expectedCeiling     any
+		expectedPredecessor any
+		expectedSuccessor   any
+	}{
+		"key in the tree": {
+			key:                 6,
+			expectedFloor:       6,
+			expectedCeiling:     6,
+			expectedPredecessor: 4,
+			expectedSuccessor:   7,
+		},
+		"key between two keys": {
+			key:             11,
+			expectedFloor:   10,
+			expectedCeiling: 13,
+		},
+		"key smaller than every key": {
+			key:             0,
+			expectedFloor:   nil,
+			expectedCeiling: 1,
+		},
+		"key larger than every key": {
+			key:             20,
+			expectedFloor:   14,
+			expectedCeiling: nil,
+		},
+		"smallest key": {
+			key:                 1,
+			expectedFloor:       1,
+			expectedCeiling:     1,
+			expectedPredecessor: nil,
+			expectedSuccessor:   3,
+		},
+		"largest key": {
+			key:                 14,
+			expectedFloor:       14,
+			expectedCeiling:     14,
+			expectedPredecessor: 13,
+			expectedSuccessor:   nil,
+		},
+		"successor is an ancestor": {
+			key:                 7,
+			expectedFloor:       7,
+			expectedCeiling:     7,
+			expectedPredecessor: 6,
+			expectedSuccessor:   8,
+		},
+		"predecessor is an ancestor": {
+			key:                 13,
+			expectedFloor:       13,
+			expectedCeiling:     13,
+			expectedPredecessor: 10,
+			expectedSuccessor:   14,
+		},
+	}
+
+	keyOf := func(node *binary.Node) any {
+		if node == nil {
+			return nil
+		}
+		return node.Element().GetKey()
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, i := range elements {
+				bst.Insert(i)
+			}
+
+			assert.Equal(t, tc.expectedFloor, keyOf(bst.Floor(tc.key)))
+			assert.Equal(t, tc.expectedCeiling, keyOf(bst.Ceiling(tc.key)))
+
+			node := bst.Search(tc.key)
+			if node == nil {
+				return
+			}
+			assert.Equal(t, tc.expectedPredecessor, keyOf(bst.Predecessor(node)))
+			assert.Equal(t, tc.expectedSuccessor, keyOf(bst.Successor(node)))
+		})
+	}
+}
+
+func TestBinaryTreeWalk(t *testing.T) {
+	bst := binary.NewTree()
+	assert.Nil(t, bst.Min())
+	assert.Nil(t, bst.Max())
+
+	for _, key := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13} {
+		bst.Insert(item{key: key})
+	}
+	bst.Remove(3)
+	bst.Remove(8)
+
+	var forward []int
+	for node := bst.Min(); node != nil; node = bst.Successor(node) {
+		forward = append(forward, node.Element().GetKey())
+	}
+	assert.Equal(t, []int{1, 4, 6, 7, 10, 13, 14}, forward)
+
+	var backward []int
+	for node := bst.Max(); node != nil; node = bst.Predecessor(node) {
+		backward = append(backward, node.Element().GetKey())
+	}
+	assert.Equal(t, []int{14, 13, 10, 7, 6, 4, 1}, backward)
+}
+
+func TestBinaryTreeRange(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+
+	testCases := map[string]struct {
+		lo, hi            int
+		expectedKeys      []int
+		expectedRemaining string
+	}{
+		"range inside the left subtree": {
+			lo:                2,
+			hi:                6,
+			expectedKeys:      []int{3, 4, 6},
+			expectedRemaining: "[1] [7] [8] [10] [13] [14] ",
+		},
+		"range across the root": {
+			lo:                5,
+			hi:                11,
+			expectedKeys:      []int{6, 7, 8, 10},
+			expectedRemaining: "[1] [3] [4] [13] [14] ",
+		},
+		"range with bounds in the tree": {
+			lo:                4,
+			hi:                13,
+			expectedKeys:      []int{4, 6, 7, 8, 10, 13},
+			expectedRemaining: "[1] [3] [14] ",
+		},
+		"single key": {
+			lo:                10,
+			hi:                10,
+			expectedKeys:      []int{10},
+			expectedRemaining: "[1] [3] [4] [6] [7] [8] [13] [14] ",
+		},
+		"range covering everything": {
+			lo:                0,
+			hi:                100,
+			expectedKeys:      []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+			expectedRemaining: "",
+		},
+		"range without keys": {
+			lo:                11,
+			hi:                12,
+			expectedKeys:      nil,
+			expectedRemaining: "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+		},
+		"inverted range": {
+			lo:                10,
+			hi:                3,
+			expectedKeys:      nil,
+			expectedRemaining: "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, i := range elements {
+				bst.Insert(i)
+			}
+
+			var keys []int
+			for el := range bst.Range(tc.lo, tc.hi) {
+				keys = append(keys, el.GetKey())
+			}
+			assert.Equal(t, tc.expectedKeys, keys)
+			assert.Equal(t, len(tc.expectedKeys), bst.CountRange(tc.lo, tc.hi))
+
+			assert.Equal(t, len(tc.expectedKeys), bst.DeleteRange(tc.lo, tc.hi))
+			assert.Equal(t, tc.expectedRemaining, bst.Traverse(binary.InOrder))
+			assert.Equal(t, len(elements)-len(tc.expectedKeys), bst.Len())
+			assert.Equal(t, 0, bst.CountRange(tc.lo, tc.hi))
+
+			var walked string
+			for node := bst.Min(); node != nil; node = bst.Successor(node) {
+				walked += fmt.Sprintf("[%d] ", node.Element().GetKey())
+			}
+			assert.Equal(t, tc.expectedRemaining, walked)
+		})
+	}
+}
+
+func TestBinaryTreeDeleteRangeRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for range 200 {
+		bst := binary.NewTree()
+		present := make(map[int]bool)
+		for range 60 {
+			key := r.IntN(100)
+			if !present[key] {
+				bst.Insert(item{key: key})
+				present[key] = true
+			}
+		}
+
+		lo := r.IntN(100)
+		hi := lo + r.IntN(40)
+		expectedRemoved := 0
+		for key := range present {
+			if key >= lo && key <= hi {
+				expectedRemoved++
+				delete(present, key)
+			}
+		}
+
+		assert.Equal(t, expectedRemoved, bst.DeleteRange(lo, hi))
+		assert.Equal(t, len(present), bst.Len())
+		for key := range 100 {
+			assert.Equal(t, present[key], bst.Search(key) != nil)
+			if present[key] {
+				assert.Equal(t, key, bst.Select(bst.Rank(key)).Element().GetKey())
+			}
+		}
+	}
+}
+
+func TestBinaryTreeDuplicatePolicy(t *testing.T) {
+	type keyValue struct {
+		key   int
+		value string
+	}
+
+	elements := []keyValue{
+		{key: 8, value: "a"},
+		{key: 3, value: "b"},
+		{key: 10, value: "c"},
+		{key: 3, value: "d"},
+		{key: 8, value: "e"},
+		{key: 3, value: "f"},
+	}
+
+	testCases := map[string]struct {
+		options           []binary.Option
+		expectedErrors    []error
+		expectedInOrder   string
+		expectedLen       int
+		expectedSearchAll []string
+		expectedAfterOne  []string
+		expectedRank      int
+	}{
+		"multiset by default": {
+			expectedErrors:    []error{nil, nil, nil, nil, nil, nil},
+			expectedInOrder:   "[3] [3] [3] [8] [8] [10] ",
+			expectedLen:       6,
+			expectedSearchAll: []string{"b", "d", "f"},
+			expectedAfterOne:  []string{"b", "d"},
+			expectedRank:      3,
+		},
+		"multiset": {
+			options:           []binary.Option{binary.WithDuplicatePolicy(binary.Multiset)},
+			expectedErrors:    []error{nil, nil, nil, nil, nil, nil},
+			expectedInOrder:   "[3] [3] [3] [8] [8] [10] ",
+			expectedLen:       6,
+			expectedSearchAll: []string{"b", "d", "f"},
+			expectedAfterOne:  []string{"b", "d"},
+			expectedRank:      3,
+		},
+		"reject": {
+			options: []binary.Option{binary.WithDuplicatePolicy(binary.Reject)},
+			expectedErrors: []error{
+				nil, nil, nil,
+				binary.ErrDuplicateKey,
+				binary.ErrDuplicateKey,
+				binary.ErrDuplicateKey,
+			},
+			expectedInOrder:   "[3] [8] [10] ",
+			expectedLen:       3,
+			expectedSearchAll: []string{"b"},
+			expectedAfterOne:  nil,
+			expectedRank:      1,
+		},
+		"replace": {
+			options:           []binary.Option{binary.WithDuplicatePolicy(binary.Replace)},
+			expectedErrors:    []error{nil, nil, nil, nil, nil, nil},
+			expectedInOrder:   "[3] [8] [10] ",
+			expectedLen:       3,
+			expectedSearchAll: []string{"f"},
+			expectedAfterOne:  nil,
+			expectedRank:      1,
+		},
+	}
+
+	values := func(elements []element.GetterSetter) []string {
+		var result []string
+		for _, el := range elements {
+			result = append(result, el.(namedItem).name)
+		}
+		return result
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree(tc.options...)
+			for i, kv := range elements {
+				err := bst.Insert(namedItem{key: kv.key, name: kv.value})
+				assert.ErrorIs(t, err, tc.expectedErrors[i])
+			}
+
 			assert.Equal(t, tc.expectedInOrder, bst.Traverse(binary.InOrder))
-			assert.Equal(t, tc.expectedPreOrder, bst.Traverse(binary.PreOrder))
-			assert.Equal(t, tc.expectedPostOrder, bst.Traverse(binary.PostOrder))
+			assert.Equal(t, tc.expectedLen, bst.Len())
+			assert.Equal(t, tc.expectedSearchAll, values(bst.SearchAll(3)))
+			assert.Equal(t, tc.expectedSearchAll[0], bst.Search(3).Element().(namedItem).name)
+			assert.Equal(t, tc.expectedRank, bst.Rank(8))
+			assert.Equal(t, 8, bst.Select(tc.expectedRank).Element().GetKey())
+			assert.Nil(t, bst.SearchAll(5))
+
+			var all []int
+			for el := range bst.All(binary.InOrder) {
+				all = append(all, el.GetKey())
+			}
+			assert.Len(t, all, tc.expectedLen)
+
+			bst.Remove(3)
+			assert.Equal(t, tc.expectedAfterOne, values(bst.SearchAll(3)))
+			assert.Equal(t, tc.expectedLen-1, bst.Len())
+
+			assert.Equal(t, tc.expectedLen-2, bst.CountRange(0, 9))
+			assert.Equal(t, tc.expectedLen-2, bst.DeleteRange(0, 9))
+			assert.Equal(t, "[10] ", bst.Traverse(binary.InOrder))
+			assert.Equal(t, 1, bst.Len())
 		})
 	}
 }
+
+func TestBinaryTreeLevels(t *testing.T) {
+	testCases := map[string]struct {
+		keys               []int
+		expectedLevelOrder string
+		expectedZigZag     string
+		expectedLevels     [][]int
+	}{
+		"random elements": {
+			keys:               []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedLevelOrder: "[8] [3] [10] [1] [6] [14] [4] [7] [13] ",
+			expectedZigZag:     "[8] [10] [3] [1] [6] [14] [13] [7] [4] ",
+			expectedLevels:     [][]int{{8}, {3, 10}, {1, 6, 14}, {4, 7, 13}},
+		},
+		"ascending elements": {
+			keys:               []int{1, 2, 3},
+			expectedLevelOrder: "[1] [2] [3] ",
+			expectedZigZag:     "[1] [2] [3] ",
+			expectedLevels:     [][]int{{1}, {2}, {3}},
+		},
+		"duplicated elements": {
+			keys:               []int{5, 2, 7, 2, 9, 1},
+			expectedLevelOrder: "[5] [2] [2] [7] [1] [9] ",
+			expectedZigZag:     "[5] [7] [2] [2] [1] [9] ",
+			expectedLevels:     [][]int{{5}, {2, 7}, {1, 9}},
+		},
+		"no elements": {
+			keys:               []int{},
+			expectedLevelOrder: "",
+			expectedZigZag:     "",
+			expectedLevels:     nil,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+
+			assert.Equal(t, tc.expectedLevelOrder, bst.Traverse(binary.LevelOrder))
+			assert.Equal(t, tc.expectedZigZag, bst.Traverse(binary.ZigZag))
+			assert.Equal(t, tc.expectedLevels, bst.Levels())
+
+			var zigZag string
+			for el := range bst.All(binary.ZigZag) {
+				zigZag += fmt.Sprintf("[%d] ", el.GetKey())
+			}
+			assert.Equal(t, tc.expectedZigZag, zigZag)
+		})
+	}
+}
+
+func TestBinaryTreeIterativeTraversalDegenerate(t *testing.T) {
+	const n = 10_000
+
+	bst := binary.NewTree()
+	expected := ""
+	for key := range n {
+		bst.Insert(item{key: key})
+		expected += fmt.Sprintf("[%d] ", key)
+	}
+
+	assert.Equal(t, expected, bst.Traverse(binary.InOrderIterative))
+	assert.Equal(t, expected, bst.Traverse(binary.PreOrderIterative))
+	assert.Equal(t, expected, bst.Traverse(binary.MorrisInOrder))
+	assert.Equal(t, expected, bst.Traverse(binary.InOrder))
+}
+
+func BenchmarkBinaryTreeTraverse(b *testing.B) {
+	const n = 100_000
+	r := rand.New(rand.NewPCG(1, 2))
+
+	random := binary.NewTree()
+	for _, key := range r.Perm(n) {
+		random.Insert(item{key: key})
+	}
+
+	// inserting ascending keys makes a chain, which is
+	// quadratic to build, so it is kept smaller
+	degenerate := binary.NewTree()
+	for key := range n / 10 {
+		degenerate.Insert(item{key: key})
+	}
+
+	trees := map[string]*binary.Tree{
+		"random":     random,
+		"degenerate": degenerate,
+	}
+	algorithms := map[string]binary.TraverseAlgorithm{
+		"recursive": binary.InOrder,
+		"iterative": binary.InOrderIterative,
+		"morris":    binary.MorrisInOrder,
+	}
+
+	for treeLabel, tree := range trees {
+		for algorithmLabel, algorithm := range algorithms {
+			b.Run(treeLabel+"/"+algorithmLabel, func(b *testing.B) {
+				for range b.N {
+					tree.Traverse(algorithm)
+				}
+			})
+		}
+	}
+}
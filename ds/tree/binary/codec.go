@@ -0,0 +1,331 @@
+package binary
+
+import (
+	"bytes"
+	encbinary "encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+// version is the first byte of the binary encoding,
+// to tell it apart from future ones.
+const version byte = 1
+
+const (
+	nilMarker byte = iota
+	nodeMarker
+)
+
+var (
+	// ErrNoCodec is returned when a Tree without a
+	// Codec is marshalled or unmarshalled.
+	ErrNoCodec = errors.New("binary: no codec")
+
+	// ErrCorrupted is returned when the data being unmarshalled
+	// is malformed or does not describe a valid Tree.
+	ErrCorrupted = errors.New("binary: corrupted data")
+)
+
+// Codec converts the elements stored in a Tree to and from bytes.
+// A Node only knows its elements through the element.GetterSetter
+// interface, so the Tree needs a Codec to be marshalled and, mainly,
+// to build back the concrete elements when it is unmarshalled.
+// MarshalJSON embeds the encoded elements as they are in the JSON
+// document, so the Codec must produce valid JSON to be used with it.
+type Codec interface {
+	Encode(el element.GetterSetter) ([]byte, error)
+	Decode(data []byte) (element.GetterSetter, error)
+}
+
+// WithCodec sets the Codec used to marshal
+// and unmarshal the elements of the Tree.
+func WithCodec(codec Codec) Option {
+	return func(t *Tree) {
+		t.codec = codec
+	}
+}
+
+// MarshalBinary encodes the Tree preserving its exact shape. After
+// a version byte, the nodes are written in pre-order, each one as
+// a marker byte, followed by the number of elements it holds and
+// each element, as encoded by the Codec, prefixed by its length,
+// while every missing child is written as a single nil marker byte.
+// Numbers are written as unsigned varints.
+func (t *Tree) MarshalBinary() ([]byte, error) {
+	if t.codec == nil {
+		return nil, ErrNoCodec
+	}
+
+	buf := []byte{version}
+	err := t.preOrderWithNil(func(node *Node) error {
+		if node == nil {
+			buf = append(buf, nilMarker)
+			return nil
+		}
+		buf = append(buf, nodeMarker)
+		buf = encbinary.AppendUvarint(buf, uint64(node.Count()))
+		for _, el := range node.Elements() {
+			data, err := t.codec.Encode(el)
+			if err != nil {
+				return err
+			}
+			buf = encbinary.AppendUvarint(buf, uint64(len(data)))
+			buf = append(buf, data...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the contents of the Tree by the one
+// encoded in data by MarshalBinary, keeping its options. It returns
+// ErrCorrupted, and leaves the Tree untouched, if data is malformed,
+// if the keys are not in binary search tree order or if a Node holds
+// duplicates the DuplicatePolicy of the Tree does not allow.
+func (t *Tree) UnmarshalBinary(data []byte) error {
+	if t.codec == nil {
+		return ErrNoCodec
+	}
+
+	r := bytes.NewReader(data)
+	if v, err := r.ReadByte(); err != nil || v != version {
+		return fmt.Errorf("%w: unknown version", ErrCorrupted)
+	}
+
+	root, err := t.buildPreOrder(func() ([][]byte, error) {
+		marker, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if marker == nilMarker {
+			return nil, nil
+		}
+		if marker != nodeMarker {
+			return nil, fmt.Errorf("unknown marker %d", marker)
+		}
+
+		count, err := encbinary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 || count > uint64(r.Len()) {
+			return nil, fmt.Errorf("invalid element count %d", count)
+		}
+		payloads := make([][]byte, count)
+		for i := range payloads {
+			length, err := encbinary.ReadUvarint(r)
+			if err != nil {
+				return nil, err
+			}
+			if length > uint64(r.Len()) {
+				return nil, fmt.Errorf("invalid element length %d", length)
+			}
+			payloads[i] = make([]byte, length)
+			if _, err := io.ReadFull(r, payloads[i]); err != nil {
+				return nil, err
+			}
+		}
+		return payloads, nil
+	})
+	if err != nil {
+		return err
+	}
+	if r.Len() > 0 {
+		return fmt.Errorf("%w: trailing data", ErrCorrupted)
+	}
+
+	t.root = root
+	return nil
+}
+
+// MarshalJSON encodes the Tree preserving its exact shape, as an
+// array with the nodes in pre-order, each one being the array of
+// elements it holds, as encoded by the Codec, and every missing
+// child being null.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	if t.codec == nil {
+		return nil, ErrNoCodec
+	}
+
+	nodes := make([][]json.RawMessage, 0)
+	err := t.preOrderWithNil(func(node *Node) error {
+		if node == nil {
+			nodes = append(nodes, nil)
+			return nil
+		}
+		payloads := make([]json.RawMessage, 0, node.Count())
+		for _, el := range node.Elements() {
+			data, err := t.codec.Encode(el)
+			if err != nil {
+				return err
+			}
+			payloads = append(payloads, data)
+		}
+		nodes = append(nodes, payloads)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(nodes)
+}
+
+// UnmarshalJSON replaces the contents of the Tree by the one
+// encoded in data by MarshalJSON, keeping its options, with the
+// same checks done by UnmarshalBinary.
+func (t *Tree) UnmarshalJSON(data []byte) error {
+	if t.codec == nil {
+		return ErrNoCodec
+	}
+
+	var nodes [][]json.RawMessage
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return fmt.Errorf("%w: %w", ErrCorrupted, err)
+	}
+
+	next := 0
+	root, err := t.buildPreOrder(func() ([][]byte, error) {
+		if next >= len(nodes) {
+			return nil, errors.New("unexpected end of data")
+		}
+		entry := nodes[next]
+		next++
+		if entry == nil {
+			return nil, nil
+		}
+		if len(entry) == 0 {
+			return nil, errors.New("node without elements")
+		}
+		payloads := make([][]byte, len(entry))
+		for i := range entry {
+			payloads[i] = entry[i]
+		}
+		return payloads, nil
+	})
+	if err != nil {
+		return err
+	}
+	if next != len(nodes) {
+		return fmt.Errorf("%w: trailing data", ErrCorrupted)
+	}
+
+	t.root = root
+	return nil
+}
+
+// preOrderWithNil calls visit for every node in pre-order, and
+// with nil for every missing child, stopping at the first error.
+func (t *Tree) preOrderWithNil(visit func(node *Node) error) error {
+	stack := []*Node{t.root}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if err := visit(current); err != nil {
+			return err
+		}
+		if current != nil {
+			stack = append(stack, current.right, current.left)
+		}
+	}
+	return nil
+}
+
+// slot is a place in the Tree being built waiting for a node,
+// the root or a child of parent, along with the keys allowed
+// there by the binary search tree order.
+type slot struct {
+	parent *Node
+	left   bool
+	lo, hi int
+	hasLo  bool
+	hasHi  bool
+}
+
+// buildPreOrder builds a Tree from nodes given in pre-order by
+// next, which returns the encoded elements of the next node, or
+// nil for a missing child, and returns its root. Each node takes
+// the first empty slot, and leaves slots for its right and left
+// children, with the left one on top so it is filled first.
+func (t *Tree) buildPreOrder(next func() ([][]byte, error)) (*Node, error) {
+	var root *Node
+	var nodes []*Node
+	stack := []slot{{}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		payloads, err := next()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCorrupted, err)
+		}
+		if payloads == nil {
+			continue
+		}
+
+		node, err := t.decodeNode(payloads)
+		if err != nil {
+			return nil, err
+		}
+		key := node.element.GetKey()
+		if (s.hasLo && key <= s.lo) || (s.hasHi && key >= s.hi) {
+			return nil, fmt.Errorf("%w: key %d out of order", ErrCorrupted, key)
+		}
+
+		node.parent = s.parent
+		switch {
+		case s.parent == nil:
+			root = node
+		case s.left:
+			s.parent.left = node
+		default:
+			s.parent.right = node
+		}
+		nodes = append(nodes, node)
+
+		stack = append(stack,
+			slot{parent: node, lo: key, hasLo: true, hi: s.hi, hasHi: s.hasHi},
+			slot{parent: node, left: true, lo: s.lo, hasLo: s.hasLo, hi: key, hasHi: true},
+		)
+	}
+
+	// in reverse pre-order every node comes after its
+	// descendants, so their sizes are already known
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		node.size = node.Count() + size(node.left) + size(node.right)
+	}
+	return root, nil
+}
+
+// decodeNode builds a detached Node holding the elements
+// decoded from payloads, which must all have the same key.
+func (t *Tree) decodeNode(payloads [][]byte) (*Node, error) {
+	if len(payloads) > 1 && t.policy != Multiset {
+		return nil, fmt.Errorf("%w: duplicated keys not allowed by policy", ErrCorrupted)
+	}
+
+	elements := make([]element.GetterSetter, len(payloads))
+	for i, payload := range payloads {
+		el, err := t.codec.Decode(payload)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && el.GetKey() != elements[0].GetKey() {
+			return nil, fmt.Errorf("%w: node with different keys", ErrCorrupted)
+		}
+		elements[i] = el
+	}
+
+	node := &Node{element: elements[0]}
+	if len(elements) > 1 {
+		node.duplicates = elements[1:]
+	}
+	return node, nil
+}
@@ -0,0 +1,211 @@
+package binary_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// namedItemCodec encodes namedItem as a JSON object,
+// so it can be used for both binary and JSON encodings.
+type namedItemCodec struct{}
+
+type namedItemJSON struct {
+	Key  int    `json:"key"`
+	Name string `json:"name"`
+}
+
+func (namedItemCodec) Encode(el element.GetterSetter) ([]byte, error) {
+	named := el.(namedItem)
+	return json.Marshal(namedItemJSON{Key: named.key, Name: named.name})
+}
+
+func (namedItemCodec) Decode(data []byte) (element.GetterSetter, error) {
+	var named namedItemJSON
+	if err := json.Unmarshal(data, &named); err != nil {
+		return nil, err
+	}
+	return namedItem{key: named.Key, name: named.Name}, nil
+}
+
+func TestBinaryTreeMarshal(t *testing.T) {
+	testCases := map[string]struct {
+		keys []int
+	}{
+		"random elements": {
+			keys: []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+		},
+		"ascending elements": {
+			keys: []int{1, 2, 3, 4, 5},
+		},
+		"duplicated elements": {
+			keys: []int{5, 2, 7, 2, 5, 5, -3},
+		},
+		"single element": {
+			keys: []int{42},
+		},
+		"no elements": {
+			keys: []int{},
+		},
+	}
+
+	formats := map[string]struct {
+		marshal   func(t *binary.Tree) ([]byte, error)
+		unmarshal func(t *binary.Tree, data []byte) error
+	}{
+		"binary": {
+			marshal:   (*binary.Tree).MarshalBinary,
+			unmarshal: (*binary.Tree).UnmarshalBinary,
+		},
+		"json": {
+			marshal:   (*binary.Tree).MarshalJSON,
+			unmarshal: (*binary.Tree).UnmarshalJSON,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		for formatLabel, format := range formats {
+			t.Run(label+"/"+formatLabel, func(t *testing.T) {
+				t.Parallel()
+
+				bst := binary.NewTree(binary.WithCodec(namedItemCodec{}))
+				for i, key := range tc.keys {
+					bst.Insert(namedItem{key: key, name: string(rune('a' + i))})
+				}
+
+				data, err := format.marshal(bst)
+				require.NoError(t, err)
+
+				decoded := binary.NewTree(binary.WithCodec(namedItemCodec{}))
+				decoded.Insert(namedItem{key: 100, name: "discarded"})
+				require.NoError(t, format.unmarshal(decoded, data))
+
+				assert.Equal(t, bst.PrettyString(), decoded.PrettyString())
+				assert.Equal(t, bst.Traverse(binary.PreOrder), decoded.Traverse(binary.PreOrder))
+				assert.Equal(t, bst.Len(), decoded.Len())
+				for i := range bst.Len() {
+					assert.Equal(t, bst.Select(i).Elements(), decoded.Select(i).Elements())
+				}
+
+				var walked []element.GetterSetter
+				for node := decoded.Min(); node != nil; node = decoded.Successor(node) {
+					walked = append(walked, node.Elements()...)
+				}
+				var expected []element.GetterSetter
+				for el := range bst.All(binary.InOrder) {
+					expected = append(expected, el)
+				}
+				assert.Equal(t, expected, walked)
+			})
+		}
+	}
+}
+
+func TestBinaryTreeMarshalJSONFormat(t *testing.T) {
+	bst := binary.NewTree(binary.WithCodec(namedItemCodec{}))
+	bst.Insert(namedItem{key: 2, name: "b"})
+	bst.Insert(namedItem{key: 3, name: "c"})
+	bst.Insert(namedItem{key: 2, name: "d"})
+
+	data, err := json.Marshal(bst)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		[{"key": 2, "name": "b"}, {"key": 2, "name": "d"}],
+		null,
+		[{"key": 3, "name": "c"}],
+		null,
+		null
+	]`, string(data))
+}
+
+func TestBinaryTreeUnmarshalErrors(t *testing.T) {
+	valid, err := func() ([]byte, error) {
+		bst := binary.NewTree(binary.WithCodec(namedItemCodec{}))
+		bst.Insert(namedItem{key: 2, name: "b"})
+		bst.Insert(namedItem{key: 1, name: "a"})
+		return bst.MarshalBinary()
+	}()
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		options     []binary.Option
+		json        string
+		binary      []byte
+		expectedErr error
+	}{
+		"keys out of order": {
+			json:        `[[{"key": 2}], [{"key": 3}], null, null, null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"different keys in a node": {
+			json:        `[[{"key": 2}, {"key": 3}], null, null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"duplicates with reject policy": {
+			options:     []binary.Option{binary.WithDuplicatePolicy(binary.Reject)},
+			json:        `[[{"key": 2}, {"key": 2}], null, null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"node without elements": {
+			json:        `[[], null, null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"missing children": {
+			json:        `[[{"key": 2}], null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"trailing nodes": {
+			json:        `[null, null]`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"not an array": {
+			json:        `{"key": 2}`,
+			expectedErr: binary.ErrCorrupted,
+		},
+		"truncated binary": {
+			binary:      valid[:len(valid)-3],
+			expectedErr: binary.ErrCorrupted,
+		},
+		"trailing binary": {
+			binary:      append(append([]byte{}, valid...), 0),
+			expectedErr: binary.ErrCorrupted,
+		},
+		"unknown version": {
+			binary:      append([]byte{9}, valid[1:]...),
+			expectedErr: binary.ErrCorrupted,
+		},
+		"no codec": {
+			options:     []binary.Option{binary.WithCodec(nil)},
+			binary:      valid,
+			expectedErr: binary.ErrNoCodec,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			options := append([]binary.Option{binary.WithCodec(namedItemCodec{})}, tc.options...)
+			bst := binary.NewTree(options...)
+			bst.Insert(namedItem{key: 100, name: "kept"})
+
+			if tc.binary != nil {
+				assert.ErrorIs(t, bst.UnmarshalBinary(tc.binary), tc.expectedErr)
+			} else {
+				assert.ErrorIs(t, bst.UnmarshalJSON([]byte(tc.json)), tc.expectedErr)
+			}
+			assert.Equal(t, "[100] ", bst.Traverse(binary.InOrder))
+		})
+	}
+
+	_, err = binary.NewTree().MarshalBinary()
+	assert.ErrorIs(t, err, binary.ErrNoCodec)
+	_, err = binary.NewTree().MarshalJSON()
+	assert.ErrorIs(t, err, binary.ErrNoCodec)
+}
@@ -0,0 +1,165 @@
+package binary
+
+import (
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+// ConcurrentTree is a Tree that can be shared by several
+// goroutines. It has a sync.RWMutex, so lookups run in parallel
+// while Insert and Remove run alone. Instead of Nodes, which would
+// be read while the Tree changes, it returns the elements they
+// hold, and its iterators walk over a snapshot of the elements
+// taken when they are created, so a long loop does not hold the
+// lock nor see the changes made in the meantime. The zero value
+// is not usable, since it has no Tree to guard, so a
+// ConcurrentTree must be created by NewConcurrentTree.
+type ConcurrentTree struct {
+	mu   sync.RWMutex
+	tree *Tree
+}
+
+// Insert adds element to the ConcurrentTree, following the
+// DuplicatePolicy as Tree.Insert does.
+func (c *ConcurrentTree) Insert(element element.GetterSetter) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tree.Insert(element)
+}
+
+// Remove removes an element with key from the ConcurrentTree,
+// the last one inserted if there are duplicates.
+func (c *ConcurrentTree) Remove(key int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tree.Remove(key)
+}
+
+// DeleteRange removes every element with keys in [lo, hi] and
+// returns how many were removed.
+func (c *ConcurrentTree) DeleteRange(lo, hi int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tree.DeleteRange(lo, hi)
+}
+
+// Search returns the first element inserted with key and
+// true, or nil and false if key is not in the ConcurrentTree.
+func (c *ConcurrentTree) Search(key int) (element.GetterSetter, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return elementOf(c.tree.Search(key))
+}
+
+// SearchAll returns every element with key, in the order
+// they were inserted, or nil if key is not in the
+// ConcurrentTree.
+func (c *ConcurrentTree) SearchAll(key int) []element.GetterSetter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tree.SearchAll(key)
+}
+
+// Floor returns the element with the largest key smaller
+// than or equal to key, or nil and false if there is none.
+func (c *ConcurrentTree) Floor(key int) (element.GetterSetter, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return elementOf(c.tree.Floor(key))
+}
+
+// Ceiling returns the element with the smallest key greater
+// than or equal to key, or nil and false if there is none.
+func (c *ConcurrentTree) Ceiling(key int) (element.GetterSetter, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return elementOf(c.tree.Ceiling(key))
+}
+
+// Rank returns the number of elements in the
+// ConcurrentTree with keys smaller than key.
+func (c *ConcurrentTree) Rank(key int) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tree.Rank(key)
+}
+
+// CountRange returns the number of elements
+// with keys in [lo, hi].
+func (c *ConcurrentTree) CountRange(lo, hi int) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tree.CountRange(lo, hi)
+}
+
+// Len returns the number of elements in the ConcurrentTree.
+func (c *ConcurrentTree) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tree.Len()
+}
+
+// Traverse returns the keys in the ConcurrentTree, formatted
+// as Tree.Traverse does. MorrisInOrder links and unlinks Nodes
+// while it runs, so it takes the lock exclusively, as a write.
+func (c *ConcurrentTree) Traverse(algorithm TraverseAlgorithm) string {
+	if algorithm == MorrisInOrder {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	} else {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+	}
+
+	return c.tree.Traverse(algorithm)
+}
+
+// All returns an iterator over a snapshot of the elements in
+// the ConcurrentTree, in the order given by algorithm, as
+// Tree.All does. The snapshot is taken when All is called.
+func (c *ConcurrentTree) All(algorithm TraverseAlgorithm) iter.Seq[element.GetterSetter] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return slices.Values(slices.Collect(c.tree.All(algorithm)))
+}
+
+// Range returns an iterator over a snapshot of the elements with
+// keys in [lo, hi], in ascending order. The snapshot is taken
+// when Range is called.
+func (c *ConcurrentTree) Range(lo, hi int) iter.Seq[element.GetterSetter] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return slices.Values(slices.Collect(c.tree.Range(lo, hi)))
+}
+
+// elementOf returns the element in node and true,
+// or nil and false if node is nil.
+func elementOf(node *Node) (element.GetterSetter, bool) {
+	if node == nil {
+		return nil, false
+	}
+	return node.element, true
+}
+
+// NewConcurrentTree returns a new ConcurrentTree with no
+// elements, configured by opts as NewTree does.
+func NewConcurrentTree(opts ...Option) *ConcurrentTree {
+	return &ConcurrentTree{
+		tree: NewTree(opts...),
+	}
+}
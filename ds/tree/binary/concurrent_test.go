@@ -0,0 +1,127 @@
+package binary_test
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentTree(t *testing.T) {
+	bst := binary.NewConcurrentTree()
+	for _, key := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13, 6} {
+		assert.NoError(t, bst.Insert(item{key: key}))
+	}
+
+	el, ok := bst.Search(6)
+	assert.True(t, ok)
+	assert.Equal(t, 6, el.GetKey())
+	assert.Len(t, bst.SearchAll(6), 2)
+	_, ok = bst.Search(5)
+	assert.False(t, ok)
+
+	el, ok = bst.Floor(12)
+	assert.True(t, ok)
+	assert.Equal(t, 10, el.GetKey())
+	el, ok = bst.Ceiling(12)
+	assert.True(t, ok)
+	assert.Equal(t, 13, el.GetKey())
+	_, ok = bst.Ceiling(15)
+	assert.False(t, ok)
+
+	assert.Equal(t, 10, bst.Len())
+	assert.Equal(t, 3, bst.Rank(6))
+	assert.Equal(t, 5, bst.CountRange(4, 8))
+
+	inOrder := "[1] [3] [4] [6] [6] [7] [8] [10] [13] [14] "
+	assert.Equal(t, inOrder, bst.Traverse(binary.InOrder))
+	assert.Equal(t, inOrder, bst.Traverse(binary.MorrisInOrder))
+	assert.Equal(t, []int{4, 6, 6, 7, 8}, keysOf(bst.Range(4, 8)))
+
+	bst.Remove(6)
+	assert.Equal(t, 2, bst.DeleteRange(13, 14))
+	assert.Equal(t, []int{1, 3, 4, 6, 7, 8, 10}, keysOf(bst.All(binary.InOrder)))
+
+	dup := binary.NewConcurrentTree(binary.WithDuplicatePolicy(binary.Reject))
+	assert.NoError(t, dup.Insert(item{key: 1}))
+	assert.ErrorIs(t, dup.Insert(item{key: 1}), binary.ErrDuplicateKey)
+}
+
+func TestConcurrentTreeSnapshot(t *testing.T) {
+	bst := binary.NewConcurrentTree()
+	for key := range 100 {
+		bst.Insert(item{key: key})
+	}
+
+	// removing elements while iterating does not
+	// change the elements already in the snapshot
+	var keys []int
+	for el := range bst.Range(10, 89) {
+		keys = append(keys, el.GetKey())
+		bst.Remove(el.GetKey() + 1)
+		bst.Remove(el.GetKey() - 1)
+	}
+
+	expected := make([]int, 0, 80)
+	for key := 10; key < 90; key++ {
+		expected = append(expected, key)
+	}
+	assert.Equal(t, expected, keys)
+	assert.Equal(t, 18, bst.Len())
+}
+
+func TestConcurrentTreeParallel(t *testing.T) {
+	const (
+		writers   = 8
+		readers   = 8
+		perWorker = 500
+	)
+
+	bst := binary.NewConcurrentTree()
+
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				key := w*perWorker + i
+				bst.Insert(item{key: key})
+				if i%2 == 1 {
+					bst.Remove(key)
+				}
+			}
+		}()
+	}
+	for range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				bst.Search(i)
+				bst.Traverse(binary.MorrisInOrder)
+				keys := keysOf(bst.All(binary.InOrder))
+				assert.True(t, slices.IsSorted(keys))
+			}
+		}()
+	}
+	wg.Wait()
+
+	keys := keysOf(bst.All(binary.InOrder))
+	assert.Len(t, keys, writers*perWorker/2)
+	for _, key := range keys {
+		assert.Zero(t, key%2)
+	}
+}
+
+func keysOf(seq iter.Seq[element.GetterSetter]) []int {
+	var keys []int
+	for el := range seq {
+		keys = append(keys, el.GetKey())
+	}
+	return keys
+}
@@ -0,0 +1,55 @@
+package binary
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes the Tree to w in the Graphviz DOT language,
+// labelling each node with its key. Nodes with a single child
+// get a point-shaped placeholder in place of the missing one,
+// so left and right children can be told apart.
+func (t *Tree) WriteDOT(w io.Writer) error {
+	return t.WriteDOTFunc(w, func(node *Node) string {
+		return fmt.Sprintf("%d", node.element.GetKey())
+	})
+}
+
+// WriteDOTFunc is like WriteDOT, but labels each node with the
+// text returned by label.
+func (t *Tree) WriteDOTFunc(w io.Writer, label func(node *Node) string) error {
+	var result strings.Builder
+	result.WriteString("digraph Tree {\n")
+	result.WriteString("\tnode [shape=circle];\n")
+
+	// nodes are numbered in breadth-first order, so the children
+	// of a node are numbered when it is visited
+	ids := map[*Node]int{t.root: 0}
+	next, placeholders := 1, 0
+	t.levels(func(level []*Node) bool {
+		for _, node := range level {
+			id := ids[node]
+			fmt.Fprintf(&result, "\tn%d [label=%q];\n", id, label(node))
+			if node.left == nil && node.right == nil {
+				continue
+			}
+			for _, child := range []*Node{node.left, node.right} {
+				if child != nil {
+					ids[child] = next
+					fmt.Fprintf(&result, "\tn%d -> n%d;\n", id, next)
+					next++
+					continue
+				}
+				fmt.Fprintf(&result, "\tnil%d [shape=point];\n", placeholders)
+				fmt.Fprintf(&result, "\tn%d -> nil%d;\n", id, placeholders)
+				placeholders++
+			}
+		}
+		return true
+	})
+
+	result.WriteString("}\n")
+	_, err := io.WriteString(w, result.String())
+	return err
+}
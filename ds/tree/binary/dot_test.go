@@ -0,0 +1,67 @@
+package binary_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestBinaryTreeWriteDOT(t *testing.T) {
+	testCases := map[string]struct {
+		keys   []int
+		label  func(node *binary.Node) string
+		golden string
+	}{
+		"random elements": {
+			keys:   []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			golden: "tree.dot",
+		},
+		"custom labels with duplicates": {
+			keys: []int{8, 3, 10, 3},
+			label: func(node *binary.Node) string {
+				return fmt.Sprintf("%d x%d", node.Element().GetKey(), node.Count())
+			},
+			golden: "tree_labels.dot",
+		},
+		"no elements": {
+			keys:   []int{},
+			golden: "tree_empty.dot",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+
+			var out bytes.Buffer
+			if tc.label != nil {
+				require.NoError(t, bst.WriteDOTFunc(&out, tc.label))
+			} else {
+				require.NoError(t, bst.WriteDOT(&out))
+			}
+
+			golden := filepath.Join("testdata", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, out.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), out.String())
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package binary
+
+// SetLeft, SetRight, SetParent and SetSize let the tests in binary_test
+// break the Tree on purpose, to check that Validate notices.
+
+func (n *Node) SetLeft(left *Node) {
+	n.left = left
+}
+
+func (n *Node) SetRight(right *Node) {
+	n.right = right
+}
+
+func (n *Node) SetParent(parent *Node) {
+	n.parent = parent
+}
+
+func (n *Node) SetSize(size int) {
+	n.size = size
+}
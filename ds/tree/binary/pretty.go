@@ -0,0 +1,64 @@
+package binary
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrettyString returns a drawing of the Tree lying on its side,
+// with the root on the left, right subtrees above their parents
+// and left subtrees below them, using box-drawing characters to
+// link each node to its parent:
+//
+//	    ┌── 14
+//	┌── 10
+//	8
+//	│   ┌── 6
+//	└── 3
+//	    └── 1
+//
+// As each key gets a line of its own, keys of any width, negative
+// ones included, never overlap. Nodes holding duplicates show how
+// many elements they hold, as in "3 (x2)". It returns "(empty)\n"
+// if the Tree is empty.
+func (t *Tree) PrettyString() string {
+	if t.root == nil {
+		return "(empty)\n"
+	}
+	var result strings.Builder
+	t.pretty(t.root.right, "", true, &result)
+	t.writeNode(t.root, &result)
+	t.pretty(t.root.left, "", false, &result)
+	return result.String()
+}
+
+// pretty draws the subtree rooted at node, a right child if
+// isRight is true, a left child otherwise, with every line
+// starting with prefix.
+func (t *Tree) pretty(node *Node, prefix string, isRight bool, result *strings.Builder) {
+	if node == nil {
+		return
+	}
+
+	// the vertical line linking a node to its parent runs
+	// through the lines of its subtree closer to the parent
+	above, below := "    ", "│   "
+	connector := "┌── "
+	if !isRight {
+		above, below = "│   ", "    "
+		connector = "└── "
+	}
+
+	t.pretty(node.right, prefix+above, true, result)
+	result.WriteString(prefix + connector)
+	t.writeNode(node, result)
+	t.pretty(node.left, prefix+below, false, result)
+}
+
+func (t *Tree) writeNode(node *Node, result *strings.Builder) {
+	fmt.Fprintf(result, "%d", node.element.GetKey())
+	if node.Count() > 1 {
+		fmt.Fprintf(result, " (x%d)", node.Count())
+	}
+	result.WriteString("\n")
+}
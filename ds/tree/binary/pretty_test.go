@@ -0,0 +1,65 @@
+package binary_test
+
+import (
+	"testing"
+
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryTreePrettyString(t *testing.T) {
+	testCases := map[string]struct {
+		keys     []int
+		expected string
+	}{
+		"random elements": {
+			keys: []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expected: "" +
+				"    ┌── 14\n" +
+				"    │   └── 13\n" +
+				"┌── 10\n" +
+				"8\n" +
+				"│       ┌── 7\n" +
+				"│   ┌── 6\n" +
+				"│   │   └── 4\n" +
+				"└── 3\n" +
+				"    └── 1\n",
+		},
+		"wide and negative keys": {
+			keys: []int{0, -1000000, 250000, -7, 99},
+			expected: "" +
+				"┌── 250000\n" +
+				"│   └── 99\n" +
+				"0\n" +
+				"│   ┌── -7\n" +
+				"└── -1000000\n",
+		},
+		"duplicated elements": {
+			keys: []int{5, 5, 2, 2, 2},
+			expected: "" +
+				"5 (x2)\n" +
+				"└── 2 (x3)\n",
+		},
+		"single element": {
+			keys:     []int{42},
+			expected: "42\n",
+		},
+		"no elements": {
+			keys:     []int{},
+			expected: "(empty)\n",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+			assert.Equal(t, tc.expected, bst.PrettyString())
+		})
+	}
+}
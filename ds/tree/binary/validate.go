@@ -0,0 +1,185 @@
+package binary
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTree is returned, wrapped with the details of the
+// first violation found, by Validate when the Tree is broken.
+var ErrInvalidTree = errors.New("binary: invalid tree")
+
+// bounded is a Node waiting to be checked by Validate, along
+// with the keys allowed in its subtree by its ancestors.
+type bounded struct {
+	node   *Node
+	lo, hi int
+	hasLo  bool
+	hasHi  bool
+}
+
+// Validate checks the invariants of the Tree, returning an error
+// wrapping ErrInvalidTree that describes the first violation found,
+// or nil if there is none. It checks that every key is greater than
+// the keys on its left subtree and smaller than the keys on its
+// right subtree, that every Node is reached only once, so there are
+// no cycles, that the parent of every Node is the Node pointing to
+// it, that duplicates share their Node's key and are only held with
+// the Multiset policy, and that the subtree sizes are up to date.
+// It walks the Tree with an explicit stack, so it runs in O(n)
+// even on degenerate trees.
+func (t *Tree) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("%w: root %d has a parent", ErrInvalidTree, t.root.element.GetKey())
+	}
+
+	visited := make(map[*Node]bool)
+	stack := []bounded{{node: t.root}}
+	var order []*Node
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		node := current.node
+		key := node.element.GetKey()
+		if visited[node] {
+			return fmt.Errorf("%w: node %d is reached more than once", ErrInvalidTree, key)
+		}
+		visited[node] = true
+		order = append(order, node)
+
+		if (current.hasLo && key <= current.lo) || (current.hasHi && key >= current.hi) {
+			return fmt.Errorf("%w: key %d is out of order", ErrInvalidTree, key)
+		}
+		if len(node.duplicates) > 0 && t.policy != Multiset {
+			return fmt.Errorf("%w: node %d holds duplicates", ErrInvalidTree, key)
+		}
+		for _, el := range node.duplicates {
+			if el.GetKey() != key {
+				return fmt.Errorf("%w: node %d holds key %d", ErrInvalidTree, key, el.GetKey())
+			}
+		}
+
+		for _, child := range []*Node{node.left, node.right} {
+			if child != nil && child.parent != node {
+				return fmt.Errorf("%w: parent of %d is not %d", ErrInvalidTree, child.element.GetKey(), key)
+			}
+		}
+		if node.left != nil {
+			stack = append(stack, bounded{node: node.left, lo: current.lo, hasLo: current.hasLo, hi: key, hasHi: true})
+		}
+		if node.right != nil {
+			stack = append(stack, bounded{node: node.right, lo: key, hasLo: true, hi: current.hi, hasHi: current.hasHi})
+		}
+	}
+
+	// children come after their parent in order, so going
+	// backwards every subtree is checked before its root
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+		if node.size != node.Count()+size(node.left)+size(node.right) {
+			return fmt.Errorf("%w: size of %d is %d", ErrInvalidTree, node.element.GetKey(), node.size)
+		}
+	}
+	return nil
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree) Height() int {
+	height := 0
+	t.levels(func([]*Node) bool {
+		height++
+		return true
+	})
+	return height
+}
+
+// Size returns the number of Nodes in the Tree, which is
+// smaller than Len when the Tree holds duplicated keys.
+func (t *Tree) Size() int {
+	nodes := 0
+	t.levels(func(level []*Node) bool {
+		nodes += len(level)
+		return true
+	})
+	return nodes
+}
+
+// MinDepth returns the number of levels from the root down to
+// the closest leaf, 0 for an empty Tree. The Tree is visited
+// breadth-first, stopping at the first level with a leaf.
+func (t *Tree) MinDepth() int {
+	depth := 0
+	t.levels(func(level []*Node) bool {
+		depth++
+		for _, node := range level {
+			if node.left == nil && node.right == nil {
+				return false
+			}
+		}
+		return true
+	})
+	return depth
+}
+
+// Diameter returns the number of edges in the longest path
+// between two Nodes of the Tree, which may or may not go through
+// the root. It is 0 for a Tree with less than two Nodes. The
+// heights of the subtrees are computed in post-order with an
+// explicit stack.
+func (t *Tree) Diameter() int {
+	diameter := 0
+	heights := make(map[*Node]int)
+	var stack []*Node
+	var last *Node
+	current := t.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.left
+		}
+		top := stack[len(stack)-1]
+		if top.right != nil && top.right != last {
+			current = top.right
+			continue
+		}
+		stack = stack[:len(stack)-1]
+
+		// a missing child has height 0, so the sum of the
+		// heights is the length of the longest path through top
+		left, right := heights[top.left], heights[top.right]
+		diameter = max(diameter, left+right)
+		heights[top] = 1 + max(left, right)
+		last = top
+	}
+	return diameter
+}
+
+// LowestCommonAncestor returns the deepest Node having both
+// the Nodes with keys a and b in its subtree, a Node being in
+// its own subtree. It returns nil if either key is not in the
+// Tree.
+func (t *Tree) LowestCommonAncestor(a, b int) *Node {
+	if t.Search(a) == nil || t.Search(b) == nil {
+		return nil
+	}
+
+	lo, hi := min(a, b), max(a, b)
+	current := t.root
+	for current != nil {
+		key := current.element.GetKey()
+		switch {
+		case hi < key:
+			current = current.left
+		case lo > key:
+			current = current.right
+		default:
+			return current
+		}
+	}
+	return nil
+}
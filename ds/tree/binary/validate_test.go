@@ -0,0 +1,218 @@
+package binary_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryTreeValidate(t *testing.T) {
+	testCases := map[string]struct {
+		keys        []int
+		corrupt     func(bst *binary.Tree)
+		expectedErr error
+	}{
+		"valid tree": {
+			keys:    []int{8, 3, 10, 1, 6, 14, 4, 7, 13, 6},
+			corrupt: func(*binary.Tree) {},
+		},
+		"empty tree": {
+			keys:    []int{},
+			corrupt: func(*binary.Tree) {},
+		},
+		"key out of order": {
+			keys: []int{8, 3, 10, 1, 6},
+			corrupt: func(bst *binary.Tree) {
+				// 6 is moved from the right of 3 to the left
+				// of 10, which is the right subtree of 8
+				three, ten, six := bst.Search(3), bst.Search(10), bst.Search(6)
+				three.SetRight(nil)
+				three.SetSize(2)
+				ten.SetLeft(six)
+				ten.SetSize(2)
+				six.SetParent(ten)
+			},
+			expectedErr: binary.ErrInvalidTree,
+		},
+		"wrong parent": {
+			keys: []int{8, 3, 10},
+			corrupt: func(bst *binary.Tree) {
+				bst.Search(3).SetParent(bst.Search(10))
+			},
+			expectedErr: binary.ErrInvalidTree,
+		},
+		"root with parent": {
+			keys: []int{8, 3, 10},
+			corrupt: func(bst *binary.Tree) {
+				bst.Search(8).SetParent(bst.Search(3))
+			},
+			expectedErr: binary.ErrInvalidTree,
+		},
+		"cycle": {
+			keys: []int{8, 3},
+			corrupt: func(bst *binary.Tree) {
+				three := bst.Search(3)
+				three.SetLeft(three)
+				three.SetParent(three)
+			},
+			expectedErr: binary.ErrInvalidTree,
+		},
+		"stale size": {
+			keys: []int{8, 3, 10},
+			corrupt: func(bst *binary.Tree) {
+				bst.Search(10).SetSize(2)
+			},
+			expectedErr: binary.ErrInvalidTree,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+			tc.corrupt(bst)
+
+			assert.ErrorIs(t, bst.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestBinaryTreeValidateRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+
+	bst := binary.NewTree()
+	for range 2_000 {
+		key := r.IntN(500)
+		if r.IntN(3) == 0 {
+			bst.Remove(key)
+		} else {
+			bst.Insert(item{key: key})
+		}
+		if err := bst.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bst.DeleteRange(100, 300)
+	assert.NoError(t, bst.Validate())
+	bst.Rebalance()
+	assert.NoError(t, bst.Validate())
+}
+
+func TestBinaryTreeMetrics(t *testing.T) {
+	testCases := map[string]struct {
+		keys             []int
+		expectedHeight   int
+		expectedSize     int
+		expectedMinDepth int
+		expectedDiameter int
+	}{
+		"balanced tree": {
+			keys:             []int{4, 2, 6, 1, 3, 5, 7},
+			expectedHeight:   3,
+			expectedSize:     7,
+			expectedMinDepth: 3,
+			expectedDiameter: 4,
+		},
+		"unbalanced tree": {
+			keys:             []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedHeight:   4,
+			expectedSize:     9,
+			expectedMinDepth: 3,
+			expectedDiameter: 6,
+		},
+		"longest path not through the root": {
+			keys:             []int{10, 4, 2, 7, 1, 3, 6, 8, 5, 9},
+			expectedHeight:   5,
+			expectedSize:     10,
+			expectedMinDepth: 4,
+			expectedDiameter: 5,
+		},
+		"duplicated keys": {
+			keys:             []int{2, 1, 2, 3, 3},
+			expectedHeight:   2,
+			expectedSize:     3,
+			expectedMinDepth: 2,
+			expectedDiameter: 2,
+		},
+		"chain": {
+			keys:             []int{1, 2, 3, 4},
+			expectedHeight:   4,
+			expectedSize:     4,
+			expectedMinDepth: 4,
+			expectedDiameter: 3,
+		},
+		"single node": {
+			keys:             []int{1},
+			expectedHeight:   1,
+			expectedSize:     1,
+			expectedMinDepth: 1,
+			expectedDiameter: 0,
+		},
+		"empty tree": {
+			keys:             []int{},
+			expectedHeight:   0,
+			expectedSize:     0,
+			expectedMinDepth: 0,
+			expectedDiameter: 0,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			bst := binary.NewTree()
+			for _, key := range tc.keys {
+				bst.Insert(item{key: key})
+			}
+
+			assert.Equal(t, tc.expectedHeight, bst.Height())
+			assert.Equal(t, tc.expectedSize, bst.Size())
+			assert.Equal(t, tc.expectedMinDepth, bst.MinDepth())
+			assert.Equal(t, tc.expectedDiameter, bst.Diameter())
+		})
+	}
+}
+
+func TestBinaryTreeLowestCommonAncestor(t *testing.T) {
+	bst := binary.NewTree()
+	for _, key := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13} {
+		bst.Insert(item{key: key})
+	}
+
+	testCases := map[string]struct {
+		a, b        int
+		expectedKey int
+		expectedNil bool
+	}{
+		"different subtrees":    {a: 1, b: 13, expectedKey: 8},
+		"same subtree":          {a: 4, b: 7, expectedKey: 6},
+		"reversed arguments":    {a: 7, b: 1, expectedKey: 3},
+		"ancestor of the other": {a: 10, b: 13, expectedKey: 10},
+		"same key":              {a: 4, b: 4, expectedKey: 4},
+		"missing key":           {a: 4, b: 5, expectedNil: true},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			node := bst.LowestCommonAncestor(tc.a, tc.b)
+			if tc.expectedNil {
+				assert.Nil(t, node)
+				return
+			}
+			assert.Equal(t, tc.expectedKey, node.Element().GetKey())
+		})
+	}
+}
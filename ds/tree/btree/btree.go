@@ -0,0 +1,362 @@
+// This package implements a B-tree, a balanced search tree
+// whose nodes hold many keys each
+
+package btree
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+// node is a node in a Tree, holding between degree-1 and
+// 2*degree-1 elements sorted by key, but the root, which may
+// hold less. An internal node has one child more than it has
+// elements, children[i] holding the keys between the ones of
+// elements[i-1] and elements[i], while a leaf has no children.
+type node struct {
+	elements []element.Getter
+	children []*node
+}
+
+func (n *node) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+// find returns the position of key among the elements of
+// the node, or where it would be, and whether it is there.
+func (n *node) find(key int) (int, bool) {
+	return slices.BinarySearchFunc(n.elements, key, func(el element.Getter, key int) int {
+		return cmp.Compare(el.GetKey(), key)
+	})
+}
+
+// Tree is a B-tree of minimum degree degree, where every node
+// holds up to 2*degree-1 elements in a single slice and all the
+// leaves are at the same depth. Compared to a binary search tree,
+// it has about log2(degree) times fewer levels and allocates one
+// node per many elements, which makes it friendlier to the CPU
+// cache for large indexes. Search, Insert and Delete take
+// O(degree * log n / log degree), going down a single path from
+// the root. Elements are indexed by key, so inserting an element
+// whose key is already in the Tree replaces the existing one.
+type Tree struct {
+	root   *node
+	degree int
+	size   int
+}
+
+// Insert adds element to the Tree, replacing the element with
+// the same key, if any. Full nodes found on the way down are
+// split before going into them, so there is always room for the
+// median key a split moves up to the parent. If the root is
+// full, it is split first and the Tree grows one level.
+func (t *Tree) Insert(element element.Getter) {
+	if t.root == nil {
+		t.root = t.newNode(true)
+	}
+	if t.isFull(t.root) {
+		root := t.newNode(false)
+		root.children = append(root.children, t.root)
+		t.splitChild(root, 0)
+		t.root = root
+	}
+
+	key := element.GetKey()
+	current := t.root
+	for {
+		i, found := current.find(key)
+		if found {
+			current.elements[i] = element
+			return
+		}
+		if current.isLeaf() {
+			current.elements = slices.Insert(current.elements, i, element)
+			t.size++
+			return
+		}
+
+		if t.isFull(current.children[i]) {
+			t.splitChild(current, i)
+			// the median key is now at i, and the key
+			// may be it or go to the new right child
+			switch median := current.elements[i].GetKey(); {
+			case key == median:
+				current.elements[i] = element
+				return
+			case key > median:
+				i++
+			}
+		}
+		current = current.children[i]
+	}
+}
+
+// Search returns the element with key and true, or nil
+// and false if there is no such element in the Tree.
+func (t *Tree) Search(key int) (element.Getter, bool) {
+	current := t.root
+	for current != nil {
+		i, found := current.find(key)
+		if found {
+			return current.elements[i], true
+		}
+		if current.isLeaf() {
+			return nil, false
+		}
+		current = current.children[i]
+	}
+	return nil, false
+}
+
+// Delete removes the element with key from the Tree, returning
+// true if there was one. As in Insert, it goes down a single path:
+// before going into a child with the minimum number of elements,
+// it moves an element into the child from a sibling, through the
+// parent, or merges the child with a sibling, so that removing
+// an element from a leaf never leaves it too small. An element in
+// an internal node is replaced by its predecessor or successor,
+// which is then removed from the leaf holding it. If the root is
+// left with no elements, the Tree shrinks one level.
+func (t *Tree) Delete(key int) bool {
+	if t.root == nil {
+		return false
+	}
+
+	deleted := t.delete(t.root, key)
+	if len(t.root.elements) == 0 {
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return t.size
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree) Height() int {
+	if t.root == nil {
+		return 0
+	}
+	height := 1
+	for current := t.root; !current.isLeaf(); current = current.children[0] {
+		height++
+	}
+	return height
+}
+
+// All returns an iterator over the elements of the Tree in
+// ascending order of key. The Tree must not be modified
+// while it is being iterated.
+func (t *Tree) All() iter.Seq[element.Getter] {
+	return t.Range(math.MinInt, math.MaxInt)
+}
+
+// Range returns an iterator over the elements with keys in
+// [lo, hi], in ascending order. It skips the subtrees with
+// keys smaller than lo and stops at the first key larger
+// than hi, so iterating k elements takes O(log n + k). The
+// Tree must not be modified while it is being iterated.
+func (t *Tree) Range(lo, hi int) iter.Seq[element.Getter] {
+	return func(yield func(element.Getter) bool) {
+		if t.root != nil {
+			t.walk(t.root, lo, hi, yield)
+		}
+	}
+}
+
+// walk yields the elements with keys in [lo, hi] in the
+// subtree rooted at n, returning false once there is no need
+// to go on, either because yield returned false or because
+// a key larger than hi was found.
+func (t *Tree) walk(n *node, lo, hi int, yield func(element.Getter) bool) bool {
+	i, _ := n.find(lo)
+	for ; i <= len(n.elements); i++ {
+		if !n.isLeaf() && !t.walk(n.children[i], lo, hi, yield) {
+			return false
+		}
+		if i == len(n.elements) {
+			break
+		}
+		if n.elements[i].GetKey() > hi || !yield(n.elements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// delete removes the element with key from the subtree rooted
+// at current, which has at least degree elements, unless it is
+// the root, and returns whether it was there.
+func (t *Tree) delete(current *node, key int) bool {
+	for {
+		i, found := current.find(key)
+		if current.isLeaf() {
+			if !found {
+				return false
+			}
+			current.elements = slices.Delete(current.elements, i, i+1)
+			return true
+		}
+
+		if found {
+			left, right := current.children[i], current.children[i+1]
+			switch {
+			case len(left.elements) >= t.degree:
+				// replace by the predecessor and remove it
+				predecessor := t.rightMost(left)
+				current.elements[i] = predecessor
+				key = predecessor.GetKey()
+				current = left
+			case len(right.elements) >= t.degree:
+				// replace by the successor and remove it
+				successor := t.leftMost(right)
+				current.elements[i] = successor
+				key = successor.GetKey()
+				current = right
+			default:
+				// both children are minimal, the key goes
+				// down into their merge
+				t.merge(current, i)
+				current = left
+			}
+			continue
+		}
+
+		if len(current.children[i].elements) < t.degree {
+			i = t.fill(current, i)
+		}
+		current = current.children[i]
+	}
+}
+
+// fill gives the child at position i of parent, which has
+// degree-1 elements, one element more, borrowing it from a
+// sibling through parent or, if both siblings are minimal too,
+// merging the child with one of them. It returns the new
+// position of the child.
+func (t *Tree) fill(parent *node, i int) int {
+	child := parent.children[i]
+	if i > 0 && len(parent.children[i-1].elements) >= t.degree {
+		// rotate right, from the left sibling
+		sibling := parent.children[i-1]
+		last := len(sibling.elements) - 1
+		child.elements = slices.Insert(child.elements, 0, parent.elements[i-1])
+		parent.elements[i-1] = sibling.elements[last]
+		sibling.elements = slices.Delete(sibling.elements, last, last+1)
+		if !sibling.isLeaf() {
+			last := len(sibling.children) - 1
+			child.children = slices.Insert(child.children, 0, sibling.children[last])
+			sibling.children = slices.Delete(sibling.children, last, last+1)
+		}
+		return i
+	}
+
+	if i < len(parent.elements) && len(parent.children[i+1].elements) >= t.degree {
+		// rotate left, from the right sibling
+		sibling := parent.children[i+1]
+		child.elements = append(child.elements, parent.elements[i])
+		parent.elements[i] = sibling.elements[0]
+		sibling.elements = slices.Delete(sibling.elements, 0, 1)
+		if !sibling.isLeaf() {
+			child.children = append(child.children, sibling.children[0])
+			sibling.children = slices.Delete(sibling.children, 0, 1)
+		}
+		return i
+	}
+
+	if i == len(parent.elements) {
+		i--
+	}
+	t.merge(parent, i)
+	return i
+}
+
+// splitChild splits the full child at position i of parent
+// into two nodes with degree-1 elements each, moving the
+// median element up into parent, between them.
+func (t *Tree) splitChild(parent *node, i int) {
+	child := parent.children[i]
+	median := child.elements[t.degree-1]
+
+	right := t.newNode(child.isLeaf())
+	right.elements = append(right.elements, child.elements[t.degree:]...)
+	clear(child.elements[t.degree-1:])
+	child.elements = child.elements[:t.degree-1]
+	if !child.isLeaf() {
+		right.children = append(right.children, child.children[t.degree:]...)
+		clear(child.children[t.degree:])
+		child.children = child.children[:t.degree]
+	}
+
+	parent.elements = slices.Insert(parent.elements, i, median)
+	parent.children = slices.Insert(parent.children, i+1, right)
+}
+
+// merge merges the child at position i+1 of parent into the
+// one at position i, along with the element between them in
+// parent, which must both have degree-1 elements.
+func (t *Tree) merge(parent *node, i int) {
+	left, right := parent.children[i], parent.children[i+1]
+	left.elements = append(left.elements, parent.elements[i])
+	left.elements = append(left.elements, right.elements...)
+	left.children = append(left.children, right.children...)
+
+	parent.elements = slices.Delete(parent.elements, i, i+1)
+	parent.children = slices.Delete(parent.children, i+1, i+2)
+}
+
+func (t *Tree) leftMost(n *node) element.Getter {
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+	return n.elements[0]
+}
+
+func (t *Tree) rightMost(n *node) element.Getter {
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.elements[len(n.elements)-1]
+}
+
+func (t *Tree) isFull(n *node) bool {
+	return len(n.elements) == 2*t.degree-1
+}
+
+// newNode returns an empty node with room for as many
+// elements and, unless it is a leaf, children as a node
+// can have, so they never need to be reallocated.
+func (t *Tree) newNode(leaf bool) *node {
+	n := &node{elements: make([]element.Getter, 0, 2*t.degree-1)}
+	if !leaf {
+		n.children = make([]*node, 0, 2*t.degree)
+	}
+	return n
+}
+
+// NewTree returns a new Tree with no elements and minimum
+// degree degree, so every node but the root holds between
+// degree-1 and 2*degree-1 elements. It panics if degree is
+// smaller than 2.
+func NewTree(degree int) *Tree {
+	if degree < 2 {
+		panic("btree: minimum degree smaller than 2")
+	}
+	return &Tree{
+		degree: degree,
+	}
+}
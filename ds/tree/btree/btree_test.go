@@ -0,0 +1,302 @@
+package btree_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/felipebool/dsa/ds/tree/btree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	key  int
+	name string
+}
+
+func (e item) GetKey() int {
+	return e.key
+}
+
+func (e item) SetKey(key int) {
+	e.key = key
+}
+
+func keysOf(tree *btree.Tree, lo, hi int) []int {
+	var keys []int
+	for el := range tree.Range(lo, hi) {
+		keys = append(keys, el.GetKey())
+	}
+	return keys
+}
+
+func TestBTreeInsertion(t *testing.T) {
+	testCases := map[string]struct {
+		degree         int
+		keys           []int
+		expectedKeys   []int
+		expectedHeight int
+	}{
+		"random keys": {
+			degree:         2,
+			keys:           []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedKeys:   []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+			expectedHeight: 2,
+		},
+		"ascending keys": {
+			degree:         2,
+			keys:           []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedKeys:   []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedHeight: 3,
+		},
+		"larger degree": {
+			degree:         3,
+			keys:           []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedKeys:   []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedHeight: 2,
+		},
+		"duplicated keys": {
+			degree:         2,
+			keys:           []int{2, 1, 2, 3, 2},
+			expectedKeys:   []int{1, 2, 3},
+			expectedHeight: 2,
+		},
+		"single leaf": {
+			degree:         4,
+			keys:           []int{5, 3, 1},
+			expectedKeys:   []int{1, 3, 5},
+			expectedHeight: 1,
+		},
+		"no keys": {
+			degree:         2,
+			keys:           []int{},
+			expectedKeys:   nil,
+			expectedHeight: 0,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := btree.NewTree(tc.degree)
+			for _, key := range tc.keys {
+				tree.Insert(item{key: key})
+				require.NoError(t, tree.Check())
+			}
+
+			assert.Equal(t, tc.expectedKeys, keysOf(tree, 0, 100))
+			assert.Equal(t, len(tc.expectedKeys), tree.Len())
+			assert.Equal(t, tc.expectedHeight, tree.Height())
+			for _, key := range tc.keys {
+				el, ok := tree.Search(key)
+				if assert.True(t, ok) {
+					assert.Equal(t, key, el.GetKey())
+				}
+			}
+			_, ok := tree.Search(100)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestBTreeInsertReplaces(t *testing.T) {
+	tree := btree.NewTree(2)
+	for key := range 20 {
+		tree.Insert(item{key: key, name: "old"})
+	}
+	for key := range 20 {
+		tree.Insert(item{key: key, name: "new"})
+	}
+
+	assert.Equal(t, 20, tree.Len())
+	for el := range tree.All() {
+		assert.Equal(t, "new", el.(item).name)
+	}
+	assert.NoError(t, tree.Check())
+}
+
+func TestBTreeDelete(t *testing.T) {
+	keys := []int{8, 3, 10, 1, 6, 14, 4, 7, 13, 2, 5, 9, 11, 12, 15}
+
+	testCases := map[string]struct {
+		degree       int
+		delete       []int
+		expectedKeys []int
+	}{
+		"leaf keys": {
+			degree:       2,
+			delete:       []int{1, 15, 5},
+			expectedKeys: []int{2, 3, 4, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+		},
+		"internal keys": {
+			degree:       2,
+			delete:       []int{8, 4, 12},
+			expectedKeys: []int{1, 2, 3, 5, 6, 7, 9, 10, 11, 13, 14, 15},
+		},
+		"missing keys": {
+			degree:       3,
+			delete:       []int{0, 16},
+			expectedKeys: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		},
+		"every key": {
+			degree:       2,
+			delete:       keys,
+			expectedKeys: nil,
+		},
+		"every key in order": {
+			degree:       3,
+			delete:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			expectedKeys: nil,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := btree.NewTree(tc.degree)
+			for _, key := range keys {
+				tree.Insert(item{key: key})
+			}
+
+			for _, key := range tc.delete {
+				_, ok := tree.Search(key)
+				assert.Equal(t, ok, tree.Delete(key))
+				require.NoError(t, tree.Check())
+				_, ok = tree.Search(key)
+				assert.False(t, ok)
+			}
+
+			assert.Equal(t, tc.expectedKeys, keysOf(tree, 0, 100))
+			assert.Equal(t, len(tc.expectedKeys), tree.Len())
+		})
+	}
+}
+
+func TestBTreeRange(t *testing.T) {
+	tree := btree.NewTree(2)
+	for key := 0; key < 100; key += 2 {
+		tree.Insert(item{key: key})
+	}
+
+	testCases := map[string]struct {
+		lo, hi       int
+		expectedKeys []int
+	}{
+		"bounds in the tree":     {lo: 10, hi: 20, expectedKeys: []int{10, 12, 14, 16, 18, 20}},
+		"bounds not in the tree": {lo: 11, hi: 19, expectedKeys: []int{12, 14, 16, 18}},
+		"single key":             {lo: 50, hi: 50, expectedKeys: []int{50}},
+		"no keys":                {lo: 51, hi: 51, expectedKeys: nil},
+		"inverted bounds":        {lo: 20, hi: 10, expectedKeys: nil},
+		"beyond the keys":        {lo: 95, hi: 200, expectedKeys: []int{96, 98}},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectedKeys, keysOf(tree, tc.lo, tc.hi))
+		})
+	}
+
+	// breaking out of the loop stops the iteration
+	var keys []int
+	for el := range tree.All() {
+		if el.GetKey() > 6 {
+			break
+		}
+		keys = append(keys, el.GetKey())
+	}
+	assert.Equal(t, []int{0, 2, 4, 6}, keys)
+}
+
+func TestBTreeRandom(t *testing.T) {
+	for _, degree := range []int{2, 3, 5, 16} {
+		r := rand.New(rand.NewPCG(uint64(degree), 1))
+
+		tree := btree.NewTree(degree)
+		inserted := make(map[int]bool)
+		for range 5_000 {
+			key := r.IntN(1_000)
+			if r.IntN(3) == 0 {
+				assert.Equal(t, inserted[key], tree.Delete(key))
+				delete(inserted, key)
+			} else {
+				tree.Insert(item{key: key})
+				inserted[key] = true
+			}
+		}
+		require.NoError(t, tree.Check(), "degree %d", degree)
+
+		var expected []int
+		for key := range inserted {
+			expected = append(expected, key)
+		}
+		slices.Sort(expected)
+		assert.Equal(t, expected, keysOf(tree, 0, 1_000))
+	}
+}
+
+func TestNewTreeInvalidDegree(t *testing.T) {
+	assert.Panics(t, func() {
+		btree.NewTree(1)
+	})
+}
+
+func BenchmarkInsert(b *testing.B) {
+	const size = 1_000_000
+	keys := rand.New(rand.NewPCG(1, 2)).Perm(size)
+
+	b.Run("btree", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			tree := btree.NewTree(32)
+			for _, key := range keys {
+				tree.Insert(item{key: key})
+			}
+		}
+	})
+
+	b.Run("binary", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			tree := binary.NewTree()
+			for _, key := range keys {
+				tree.Insert(item{key: key})
+			}
+		}
+	})
+}
+
+func BenchmarkSearch(b *testing.B) {
+	const size = 1_000_000
+	keys := rand.New(rand.NewPCG(1, 2)).Perm(size)
+
+	b.Run("btree", func(b *testing.B) {
+		tree := btree.NewTree(32)
+		for _, key := range keys {
+			tree.Insert(item{key: key})
+		}
+		b.ResetTimer()
+		for i := range b.N {
+			tree.Search(keys[i%size])
+		}
+	})
+
+	b.Run("binary", func(b *testing.B) {
+		tree := binary.NewTree()
+		for _, key := range keys {
+			tree.Insert(item{key: key})
+		}
+		b.ResetTimer()
+		for i := range b.N {
+			tree.Search(keys[i%size])
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package btree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Check returns an error if the Tree breaks any of the
+// B-tree invariants, so the tests in btree_test can check
+// them after every operation.
+func (t *Tree) Check() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("empty tree with size %d", t.size)
+		}
+		return nil
+	}
+	if len(t.root.elements) == 0 {
+		return errors.New("empty root")
+	}
+
+	leafDepth := -1
+	count := 0
+	var check func(n *node, depth int, lo, hi *int) error
+	check = func(n *node, depth int, lo, hi *int) error {
+		if n != t.root && len(n.elements) < t.degree-1 {
+			return fmt.Errorf("node with %d elements", len(n.elements))
+		}
+		if len(n.elements) > 2*t.degree-1 {
+			return fmt.Errorf("node with %d elements", len(n.elements))
+		}
+		for i, el := range n.elements {
+			key := el.GetKey()
+			if (i > 0 && key <= n.elements[i-1].GetKey()) || (lo != nil && key <= *lo) || (hi != nil && key >= *hi) {
+				return fmt.Errorf("key %d out of order", key)
+			}
+		}
+		count += len(n.elements)
+
+		if n.isLeaf() {
+			if leafDepth >= 0 && depth != leafDepth {
+				return fmt.Errorf("leaves at depths %d and %d", leafDepth, depth)
+			}
+			leafDepth = depth
+			return nil
+		}
+		if len(n.children) != len(n.elements)+1 {
+			return fmt.Errorf("node with %d elements and %d children", len(n.elements), len(n.children))
+		}
+		for i, child := range n.children {
+			childLo, childHi := lo, hi
+			if i > 0 {
+				key := n.elements[i-1].GetKey()
+				childLo = &key
+			}
+			if i < len(n.elements) {
+				key := n.elements[i].GetKey()
+				childHi = &key
+			}
+			if err := check(child, depth+1, childLo, childHi); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := check(t.root, 0, nil, nil); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%d elements with size %d", count, t.size)
+	}
+	return nil
+}
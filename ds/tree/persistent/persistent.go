@@ -0,0 +1,200 @@
+// This package implements a persistent binary search tree,
+// whose versions share the nodes they have in common
+
+package persistent
+
+import (
+	"fmt"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+const (
+	InOrder TraverseAlgorithm = iota
+	PreOrder
+	PostOrder
+)
+
+type TraverseAlgorithm int
+
+// Node represents a node in a Tree. Each node has an
+// element which implements the Element interface
+// (GetKey() int) and two other Nodes. A Node is never
+// modified once it is created, since it may belong to
+// several versions of the Tree.
+type Node struct {
+	element element.GetterSetter
+	left    *Node
+	right   *Node
+}
+
+// Element returns the element stored in the Node.
+func (n *Node) Element() element.GetterSetter {
+	return n.element
+}
+
+// Tree is a version of a persistent binary search tree. Instead
+// of changing the Tree, Insert and Remove return a new version,
+// copying only the Nodes in the path from the root to the one
+// they change and sharing every other Node with the previous
+// version, which remains valid and unchanged. On a balanced Tree
+// each version costs O(log n) new Nodes, but, as in binary.Tree,
+// nothing keeps the Tree balanced. The keys of the elements must
+// not change while they are in any version of the Tree.
+type Tree struct {
+	root *Node
+	size int
+}
+
+// Insert returns a new version of the Tree with element added
+// to it. An element whose key is already in the Tree gets a
+// Node of its own, in the right subtree of the existing ones.
+func (t *Tree) Insert(element element.GetterSetter) *Tree {
+	return &Tree{
+		root: t.insert(t.root, element),
+		size: t.size + 1,
+	}
+}
+
+// Search returns the Node holding key, or nil if
+// there is no such Node in the Tree.
+func (t *Tree) Search(key int) *Node {
+	current := t.root
+	for current != nil {
+		if key > current.element.GetKey() {
+			current = current.right
+			continue
+		}
+		if key < current.element.GetKey() {
+			current = current.left
+			continue
+		}
+		return current
+	}
+	return nil
+}
+
+// Remove returns a new version of the Tree without a Node
+// holding key. If key is not in the Tree, no Node is copied
+// and the Tree itself is returned.
+func (t *Tree) Remove(key int) *Tree {
+	root, removed := t.remove(t.root, key)
+	if !removed {
+		return t
+	}
+	return &Tree{
+		root: root,
+		size: t.size - 1,
+	}
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return t.size
+}
+
+func (t *Tree) Traverse(algorithm TraverseAlgorithm) string {
+	switch algorithm {
+	case InOrder:
+		return t.inOrder(t.root)
+	case PreOrder:
+		return t.preOrder(t.root)
+	case PostOrder:
+		return t.postOrder(t.root)
+	default:
+		return "unknown traversal algorithm"
+	}
+}
+
+// insert returns a copy of the subtree rooted at root with
+// element added to it, where only the Nodes in the path
+// to the new one are new.
+func (t *Tree) insert(root *Node, element element.GetterSetter) *Node {
+	if root == nil {
+		return &Node{element: element}
+	}
+	if element.GetKey() < root.element.GetKey() {
+		return &Node{element: root.element, left: t.insert(root.left, element), right: root.right}
+	}
+	return &Node{element: root.element, left: root.left, right: t.insert(root.right, element)}
+}
+
+// remove returns a copy of the subtree rooted at root without
+// a Node holding key, and whether there was one. Nothing is
+// copied if there is no such Node.
+func (t *Tree) remove(root *Node, key int) (*Node, bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	switch {
+	case key < root.element.GetKey():
+		left, removed := t.remove(root.left, key)
+		if !removed {
+			return root, false
+		}
+		return &Node{element: root.element, left: left, right: root.right}, true
+	case key > root.element.GetKey():
+		right, removed := t.remove(root.right, key)
+		if !removed {
+			return root, false
+		}
+		return &Node{element: root.element, left: root.left, right: right}, true
+	default:
+		if root.left == nil {
+			return root.right, true
+		}
+		if root.right == nil {
+			return root.left, true
+		}
+
+		// replace the node by a copy of its in-order successor
+		right, successor := t.removeMin(root.right)
+		return &Node{element: successor.element, left: root.left, right: right}, true
+	}
+}
+
+// removeMin returns a copy of the subtree rooted at root
+// without its leftmost node, along with that node.
+func (t *Tree) removeMin(root *Node) (*Node, *Node) {
+	if root.left == nil {
+		return root.right, root
+	}
+	left, min := t.removeMin(root.left)
+	return &Node{element: root.element, left: left, right: root.right}, min
+}
+
+func (t *Tree) inOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.inOrder(root.left)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.inOrder(root.right)
+	return result
+}
+
+func (t *Tree) preOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.preOrder(root.left)
+	result += t.preOrder(root.right)
+	return result
+}
+
+func (t *Tree) postOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.postOrder(root.left)
+	result += t.postOrder(root.right)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	return result
+}
+
+// NewTree returns a new, empty, version of the Tree.
+func NewTree() *Tree {
+	return &Tree{}
+}
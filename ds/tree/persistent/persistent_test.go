@@ -0,0 +1,201 @@
+package persistent_test
+
+import (
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/persistent"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	key int
+}
+
+func (e item) GetKey() int {
+	return e.key
+}
+
+func (e item) SetKey(key int) {
+	e.key = key
+}
+
+func TestPersistentTreeInsertion(t *testing.T) {
+	testCases := map[string]struct {
+		elements          []element.GetterSetter
+		expectedInOrder   string
+		expectedPreOrder  string
+		expectedPostOrder string
+	}{
+		"random elements": {
+			elements: []element.GetterSetter{
+				item{key: 8},
+				item{key: 3},
+				item{key: 10},
+				item{key: 1},
+				item{key: 6},
+				item{key: 14},
+				item{key: 4},
+				item{key: 7},
+				item{key: 13},
+			},
+			expectedInOrder:   "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder:  "[8] [3] [1] [6] [4] [7] [10] [14] [13] ",
+			expectedPostOrder: "[1] [4] [7] [6] [3] [13] [14] [10] [8] ",
+		},
+		"duplicated elements": {
+			elements: []element.GetterSetter{
+				item{key: 2},
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+			},
+			expectedInOrder:   "[1] [2] [2] [3] ",
+			expectedPreOrder:  "[2] [1] [2] [3] ",
+			expectedPostOrder: "[1] [3] [2] [2] ",
+		},
+		"no elements": {
+			elements:          []element.GetterSetter{},
+			expectedInOrder:   "",
+			expectedPreOrder:  "",
+			expectedPostOrder: "",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			versions := []*persistent.Tree{persistent.NewTree()}
+			for _, i := range tc.elements {
+				versions = append(versions, versions[len(versions)-1].Insert(i))
+			}
+			tree := versions[len(versions)-1]
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(persistent.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(persistent.PreOrder))
+			assert.Equal(t, tc.expectedPostOrder, tree.Traverse(persistent.PostOrder))
+			assert.Equal(t, len(tc.elements), tree.Len())
+
+			for _, i := range tc.elements {
+				node := tree.Search(i.GetKey())
+				if assert.NotNil(t, node) {
+					assert.Equal(t, i.GetKey(), node.Element().GetKey())
+				}
+			}
+			assert.Nil(t, tree.Search(100))
+
+			// every version holds the elements inserted before it
+			for v, version := range versions {
+				assert.Equal(t, v, version.Len())
+				for _, i := range tc.elements[:v] {
+					assert.NotNil(t, version.Search(i.GetKey()))
+				}
+			}
+		})
+	}
+}
+
+func TestPersistentTreeRemove(t *testing.T) {
+	elements := []element.GetterSetter{
+		item{key: 8},
+		item{key: 3},
+		item{key: 10},
+		item{key: 1},
+		item{key: 6},
+		item{key: 14},
+		item{key: 4},
+		item{key: 7},
+		item{key: 13},
+	}
+	original := persistent.NewTree()
+	for _, i := range elements {
+		original = original.Insert(i)
+	}
+	const originalInOrder = "[1] [3] [4] [6] [7] [8] [10] [13] [14] "
+
+	testCases := map[string]struct {
+		key              int
+		expectedInOrder  string
+		expectedPreOrder string
+		expectedLen      int
+		expectedSameTree bool
+	}{
+		"leaf": {
+			key:              13,
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [14] ",
+			expectedPreOrder: "[8] [3] [1] [6] [4] [7] [10] [14] ",
+			expectedLen:      8,
+		},
+		"node with one child": {
+			key:              14,
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [13] ",
+			expectedPreOrder: "[8] [3] [1] [6] [4] [7] [10] [13] ",
+			expectedLen:      8,
+		},
+		"node with two children": {
+			key:              3,
+			expectedInOrder:  "[1] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [4] [1] [6] [7] [10] [14] [13] ",
+			expectedLen:      8,
+		},
+		"root": {
+			key:              8,
+			expectedInOrder:  "[1] [3] [4] [6] [7] [10] [13] [14] ",
+			expectedPreOrder: "[10] [3] [1] [6] [4] [7] [14] [13] ",
+			expectedLen:      8,
+		},
+		"missing key": {
+			key:              5,
+			expectedInOrder:  originalInOrder,
+			expectedPreOrder: "[8] [3] [1] [6] [4] [7] [10] [14] [13] ",
+			expectedLen:      9,
+			expectedSameTree: true,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := original.Remove(tc.key)
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(persistent.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(persistent.PreOrder))
+			assert.Equal(t, tc.expectedLen, tree.Len())
+			assert.Equal(t, tc.expectedSameTree, tree == original)
+
+			// the original version is left untouched
+			assert.Equal(t, originalInOrder, original.Traverse(persistent.InOrder))
+			assert.Equal(t, len(elements), original.Len())
+		})
+	}
+}
+
+func TestPersistentTreeSharing(t *testing.T) {
+	tree := persistent.NewTree()
+	for _, key := range []int{4, 2, 6, 1, 3, 5, 7} {
+		tree = tree.Insert(item{key: key})
+	}
+
+	// only the path from the root to the new Node is copied
+	next := tree.Insert(item{key: 8})
+	for key, shared := range map[int]bool{
+		4: false, 6: false, 7: false,
+		2: true, 1: true, 3: true, 5: true,
+	} {
+		assert.Equal(t, shared, tree.Search(key) == next.Search(key), key)
+	}
+
+	// the same goes for removals, up to the replaced Node
+	next = tree.Remove(2)
+	for key, shared := range map[int]bool{
+		4: false, 1: true, 6: true, 5: true, 7: true,
+	} {
+		assert.Equal(t, shared, tree.Search(key) == next.Search(key), key)
+	}
+	assert.NotNil(t, tree.Search(2))
+	assert.Nil(t, next.Search(2))
+}
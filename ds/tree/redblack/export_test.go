@@ -0,0 +1,84 @@
+package redblack
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// Check returns an error if the Tree breaks any of the
+// red-black invariants, so the tests in redblack_test can
+// check them after every operation.
+func (t *Tree[K, V]) Check() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("empty tree with size %d", t.size)
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return errors.New("root with a parent")
+	}
+	if isRed(t.root) {
+		return errors.New("red root")
+	}
+
+	count := 0
+	// check returns the number of black nodes in every path
+	// from n down to its leaves, counting the nil ones
+	var check func(n *Node[K, V], lo, hi *K) (int, error)
+	check = func(n *Node[K, V], lo, hi *K) (int, error) {
+		if n == nil {
+			return 1, nil
+		}
+		count++
+		if (lo != nil && cmp.Less(n.key, *lo)) || (hi != nil && !cmp.Less(n.key, *hi)) {
+			return 0, fmt.Errorf("key %v out of order", n.key)
+		}
+		if n.color != red && n.color != black {
+			return 0, fmt.Errorf("node %v with color %d", n.key, n.color)
+		}
+		for _, child := range []*Node[K, V]{n.left, n.right} {
+			if child == nil {
+				continue
+			}
+			if child.parent != n {
+				return 0, fmt.Errorf("node %v with the wrong parent", child.key)
+			}
+			if isRed(n) && isRed(child) {
+				return 0, fmt.Errorf("red node %v with red child %v", n.key, child.key)
+			}
+		}
+
+		next := n.key
+		left, err := check(n.left, lo, &next)
+		if err != nil {
+			return 0, err
+		}
+		right, err := check(n.right, &next, hi)
+		if err != nil {
+			return 0, err
+		}
+		if left != right {
+			return 0, fmt.Errorf("node %v with black heights %d and %d", n.key, left, right)
+		}
+		if !isRed(n) {
+			left++
+		}
+		return left, nil
+	}
+
+	if _, err := check(t.root, nil, nil); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%d nodes with size %d", count, t.size)
+	}
+	return nil
+}
+
+// Rotations returns the number of rotations the
+// Tree has done since it was created.
+func (t *Tree[K, V]) Rotations() int {
+	return t.rotations
+}
@@ -0,0 +1,386 @@
+// This package implements a red-black tree storing
+// key/value pairs, that is, an ordered map
+
+package redblack
+
+import (
+	"cmp"
+	"fmt"
+)
+
+const (
+	InOrder TraverseAlgorithm = iota
+	PreOrder
+	PostOrder
+)
+
+// TraverseAlgorithm mirrors only the three depth-first orders
+// shared by every tree in this module, not the other algorithms
+// of binary.Tree.
+type TraverseAlgorithm int
+
+const (
+	red color = iota
+	black
+)
+
+type color int
+
+// Node represents a node in a Tree. Each node has a key,
+// the value associated to it, a color, its parent and two
+// children. Missing children (nil) are considered black.
+type Node[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	color  color
+	parent *Node[K, V]
+	left   *Node[K, V]
+	right  *Node[K, V]
+}
+
+// Tree is a red-black tree mapping keys of type K to values
+// of type V. It keeps itself balanced by enforcing that the
+// root is black, that red nodes have no red children and that
+// every path from a node to its leaves has the same number of
+// black nodes, so its height is at most 2 log2(n+1). Put and
+// Delete fix any violation with recolorings and at most two
+// (Put) or three (Delete) rotations.
+type Tree[K cmp.Ordered, V any] struct {
+	root      *Node[K, V]
+	size      int
+	rotations int
+}
+
+// Put associates value to key, replacing the
+// previous value if key is already in the Tree.
+func (t *Tree[K, V]) Put(key K, value V) {
+	var parent *Node[K, V]
+	current := t.root
+	for current != nil {
+		parent = current
+		switch {
+		case key < current.key:
+			current = current.left
+		case key > current.key:
+			current = current.right
+		default:
+			current.value = value
+			return
+		}
+	}
+
+	node := &Node[K, V]{key: key, value: value, color: red, parent: parent}
+	switch {
+	case parent == nil:
+		t.root = node
+	case key < parent.key:
+		parent.left = node
+	default:
+		parent.right = node
+	}
+	t.size++
+	t.insertFixup(node)
+}
+
+// Get returns the value associated to key and true,
+// or the zero value of V and false if key is not in the Tree.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	node := t.search(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Contains returns true if key is in the Tree, false otherwise.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return t.search(key) != nil
+}
+
+// Delete removes key and its value from the Tree. It returns
+// true if key was in the Tree, false otherwise.
+func (t *Tree[K, V]) Delete(key K) bool {
+	node := t.search(key)
+	if node == nil {
+		return false
+	}
+
+	// removed is the color of the node that left its position,
+	// x is the node that took it and xParent its parent, kept
+	// apart because x may be nil
+	removed := node.color
+	var x, xParent *Node[K, V]
+	switch {
+	case node.left == nil:
+		x, xParent = node.right, node.parent
+		t.transplant(node, node.right)
+	case node.right == nil:
+		x, xParent = node.left, node.parent
+		t.transplant(node, node.left)
+	default:
+		successor := leftMost(node.right)
+		removed = successor.color
+		x = successor.right
+		if successor.parent == node {
+			xParent = successor
+		} else {
+			xParent = successor.parent
+			t.transplant(successor, successor.right)
+			successor.right = node.right
+			successor.right.parent = successor
+		}
+		t.transplant(node, successor)
+		successor.left = node.left
+		successor.left.parent = successor
+		successor.color = node.color
+	}
+
+	node.parent, node.left, node.right = nil, nil, nil
+	t.size--
+	if removed == black {
+		t.deleteFixup(x, xParent)
+	}
+	return true
+}
+
+// Len returns the number of keys in the Tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree[K, V]) Height() int {
+	return height(t.root)
+}
+
+func (t *Tree[K, V]) Traverse(algorithm TraverseAlgorithm) string {
+	switch algorithm {
+	case InOrder:
+		return t.inOrder(t.root)
+	case PreOrder:
+		return t.preOrder(t.root)
+	case PostOrder:
+		return t.postOrder(t.root)
+	default:
+		return "unknown traversal algorithm"
+	}
+}
+
+func (t *Tree[K, V]) search(key K) *Node[K, V] {
+	current := t.root
+	for current != nil {
+		if key > current.key {
+			current = current.right
+			continue
+		}
+		if key < current.key {
+			current = current.left
+			continue
+		}
+		return current
+	}
+	return nil
+}
+
+// insertFixup restores the red-black properties after node
+// was inserted as a red leaf, the only possible violation being
+// node having a red parent (or node being a red root).
+func (t *Tree[K, V]) insertFixup(node *Node[K, V]) {
+	for isRed(node.parent) {
+		parent := node.parent
+		grandparent := parent.parent
+		if parent == grandparent.left {
+			uncle := grandparent.right
+			if isRed(uncle) {
+				parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				node = grandparent
+				continue
+			}
+			if node == parent.right {
+				node = parent
+				t.rotateLeft(node)
+				parent = node.parent
+			}
+			parent.color = black
+			grandparent.color = red
+			t.rotateRight(grandparent)
+			continue
+		}
+
+		uncle := grandparent.left
+		if isRed(uncle) {
+			parent.color = black
+			uncle.color = black
+			grandparent.color = red
+			node = grandparent
+			continue
+		}
+		if node == parent.left {
+			node = parent
+			t.rotateRight(node)
+			parent = node.parent
+		}
+		parent.color = black
+		grandparent.color = red
+		t.rotateLeft(grandparent)
+	}
+	t.root.color = black
+}
+
+// deleteFixup restores the red-black properties after a black
+// node was removed, leaving the subtree rooted at node, whose
+// parent is parent, one black node short.
+func (t *Tree[K, V]) deleteFixup(node, parent *Node[K, V]) {
+	for node != t.root && !isRed(node) {
+		if node == parent.left {
+			sibling := parent.right
+			if isRed(sibling) {
+				sibling.color = black
+				parent.color = red
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+			if !isRed(sibling.left) && !isRed(sibling.right) {
+				sibling.color = red
+				node, parent = parent, parent.parent
+				continue
+			}
+			if !isRed(sibling.right) {
+				sibling.left.color = black
+				sibling.color = red
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.color = parent.color
+			parent.color = black
+			sibling.right.color = black
+			t.rotateLeft(parent)
+			node = t.root
+			continue
+		}
+
+		sibling := parent.left
+		if isRed(sibling) {
+			sibling.color = black
+			parent.color = red
+			t.rotateRight(parent)
+			sibling = parent.left
+		}
+		if !isRed(sibling.left) && !isRed(sibling.right) {
+			sibling.color = red
+			node, parent = parent, parent.parent
+			continue
+		}
+		if !isRed(sibling.left) {
+			sibling.right.color = black
+			sibling.color = red
+			t.rotateLeft(sibling)
+			sibling = parent.left
+		}
+		sibling.color = parent.color
+		parent.color = black
+		sibling.left.color = black
+		t.rotateRight(parent)
+		node = t.root
+	}
+	if node != nil {
+		node.color = black
+	}
+}
+
+// transplant replaces the subtree rooted at old
+// by the one rooted at new in old's parent.
+func (t *Tree[K, V]) transplant(old, new *Node[K, V]) {
+	switch {
+	case old.parent == nil:
+		t.root = new
+	case old == old.parent.left:
+		old.parent.left = new
+	default:
+		old.parent.right = new
+	}
+	if new != nil {
+		new.parent = old.parent
+	}
+}
+
+func (t *Tree[K, V]) rotateLeft(node *Node[K, V]) {
+	t.rotations++
+	pivot := node.right
+	node.right = pivot.left
+	if pivot.left != nil {
+		pivot.left.parent = node
+	}
+	t.transplant(node, pivot)
+	pivot.left = node
+	node.parent = pivot
+}
+
+func (t *Tree[K, V]) rotateRight(node *Node[K, V]) {
+	t.rotations++
+	pivot := node.left
+	node.left = pivot.right
+	if pivot.right != nil {
+		pivot.right.parent = node
+	}
+	t.transplant(node, pivot)
+	pivot.right = node
+	node.parent = pivot
+}
+
+func (t *Tree[K, V]) inOrder(root *Node[K, V]) string {
+	if root == nil {
+		return ""
+	}
+	result := t.inOrder(root.left)
+	result += fmt.Sprintf("[%v] ", root.key)
+	result += t.inOrder(root.right)
+	return result
+}
+
+func (t *Tree[K, V]) preOrder(root *Node[K, V]) string {
+	if root == nil {
+		return ""
+	}
+	result := fmt.Sprintf("[%v] ", root.key)
+	result += t.preOrder(root.left)
+	result += t.preOrder(root.right)
+	return result
+}
+
+func (t *Tree[K, V]) postOrder(root *Node[K, V]) string {
+	if root == nil {
+		return ""
+	}
+	result := t.postOrder(root.left)
+	result += t.postOrder(root.right)
+	result += fmt.Sprintf("[%v] ", root.key)
+	return result
+}
+
+func leftMost[K cmp.Ordered, V any](node *Node[K, V]) *Node[K, V] {
+	current := node
+	for current.left != nil {
+		current = current.left
+	}
+	return current
+}
+
+func isRed[K cmp.Ordered, V any](node *Node[K, V]) bool {
+	return node != nil && node.color == red
+}
+
+func height[K cmp.Ordered, V any](node *Node[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return 1 + max(height(node.left), height(node.right))
+}
+
+func NewTree[K cmp.Ordered, V any]() *Tree[K, V] {
+	return &Tree[K, V]{}
+}
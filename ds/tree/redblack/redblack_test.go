@@ -0,0 +1,171 @@
+package redblack_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/tree/redblack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedBlackTreePut(t *testing.T) {
+	testCases := map[string]struct {
+		keys              []int
+		expectedValues    map[int]string
+		expectedInOrder   string
+		expectedPreOrder  string
+		expectedPostOrder string
+	}{
+		"random keys": {
+			keys:              []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			expectedValues:    map[int]string{8: "a", 1: "d", 13: "i"},
+			expectedInOrder:   "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder:  "[8] [3] [1] [6] [4] [7] [13] [10] [14] ",
+			expectedPostOrder: "[1] [4] [7] [6] [3] [10] [14] [13] [8] ",
+		},
+		"ascending keys": {
+			keys:              []int{1, 2, 3, 4, 5, 6, 7},
+			expectedValues:    map[int]string{1: "a", 4: "d", 7: "g"},
+			expectedInOrder:   "[1] [2] [3] [4] [5] [6] [7] ",
+			expectedPreOrder:  "[2] [1] [4] [3] [6] [5] [7] ",
+			expectedPostOrder: "[1] [3] [5] [7] [6] [4] [2] ",
+		},
+		"duplicated keys": {
+			keys:              []int{5, 3, 5, 8, 3},
+			expectedValues:    map[int]string{3: "e", 5: "c", 8: "d"},
+			expectedInOrder:   "[3] [5] [8] ",
+			expectedPreOrder:  "[5] [3] [8] ",
+			expectedPostOrder: "[3] [8] [5] ",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := redblack.NewTree[int, string]()
+			for i, key := range tc.keys {
+				tree.Put(key, string(rune('a'+i)))
+				require.NoError(t, tree.Check())
+			}
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(redblack.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(redblack.PreOrder))
+			assert.Equal(t, tc.expectedPostOrder, tree.Traverse(redblack.PostOrder))
+
+			for key, value := range tc.expectedValues {
+				got, ok := tree.Get(key)
+				assert.True(t, ok)
+				assert.Equal(t, value, got)
+				assert.True(t, tree.Contains(key))
+			}
+			got, ok := tree.Get(100)
+			assert.False(t, ok)
+			assert.Equal(t, "", got)
+			assert.False(t, tree.Contains(100))
+		})
+	}
+}
+
+func TestRedBlackTreeDelete(t *testing.T) {
+	keys := []int{8, 3, 10, 1, 6, 14, 4, 7, 13}
+
+	testCases := map[string]struct {
+		keysToDelete     []int
+		expectedDeleted  []bool
+		expectedInOrder  string
+		expectedPreOrder string
+	}{
+		"deleting leaf": {
+			keysToDelete:     []int{14},
+			expectedDeleted:  []bool{true},
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [13] ",
+			expectedPreOrder: "[8] [3] [1] [6] [4] [7] [13] [10] ",
+		},
+		"deleting node with two children": {
+			keysToDelete:     []int{3},
+			expectedDeleted:  []bool{true},
+			expectedInOrder:  "[1] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [4] [1] [6] [7] [13] [10] [14] ",
+		},
+		"deleting root": {
+			keysToDelete:     []int{8},
+			expectedDeleted:  []bool{true},
+			expectedInOrder:  "[1] [3] [4] [6] [7] [10] [13] [14] ",
+			expectedPreOrder: "[10] [3] [1] [6] [4] [7] [13] [14] ",
+		},
+		"deleting missing and repeated keys": {
+			keysToDelete:     []int{5, 1, 1},
+			expectedDeleted:  []bool{false, true, false},
+			expectedInOrder:  "[3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [6] [3] [4] [7] [13] [10] [14] ",
+		},
+		"deleting everything": {
+			keysToDelete:     []int{1, 3, 4, 6, 7, 8, 10, 13, 14},
+			expectedDeleted:  []bool{true, true, true, true, true, true, true, true, true},
+			expectedInOrder:  "",
+			expectedPreOrder: "",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := redblack.NewTree[int, int]()
+			for _, key := range keys {
+				tree.Put(key, key*10)
+			}
+
+			deleted := 0
+			for i, key := range tc.keysToDelete {
+				assert.Equal(t, tc.expectedDeleted[i], tree.Delete(key))
+				require.NoError(t, tree.Check())
+				assert.False(t, tree.Contains(key))
+				if tc.expectedDeleted[i] {
+					deleted++
+				}
+			}
+
+			assert.Equal(t, len(keys)-deleted, tree.Len())
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(redblack.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(redblack.PreOrder))
+		})
+	}
+}
+
+func TestRedBlackTreeRandomOperations(t *testing.T) {
+	const n = 2_000
+	r := rand.New(rand.NewPCG(1, 2))
+
+	tree := redblack.NewTree[int, int]()
+	expected := make(map[int]int)
+	for i := range 3 * n {
+		key := r.IntN(n)
+		rotations := tree.Rotations()
+		if r.IntN(3) == 0 {
+			_, ok := expected[key]
+			assert.Equal(t, ok, tree.Delete(key))
+			assert.LessOrEqual(t, tree.Rotations()-rotations, 3)
+			delete(expected, key)
+		} else {
+			tree.Put(key, i)
+			assert.LessOrEqual(t, tree.Rotations()-rotations, 2)
+			expected[key] = i
+		}
+		require.NoError(t, tree.Check())
+	}
+
+	assert.Equal(t, len(expected), tree.Len())
+	assert.LessOrEqual(t, float64(tree.Height()), 2*math.Log2(float64(tree.Len()+1)))
+	for key := range n {
+		value, ok := tree.Get(key)
+		assert.Equal(t, expected[key], value)
+		_, found := expected[key]
+		assert.Equal(t, found, ok)
+	}
+}
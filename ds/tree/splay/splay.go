@@ -0,0 +1,258 @@
+// This package implements a splay tree, a binary search tree
+// that moves every node it accesses to the root
+
+package splay
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+const (
+	InOrder TraverseAlgorithm = iota
+	PreOrder
+	PostOrder
+)
+
+type TraverseAlgorithm int
+
+// Node represents a node in a Tree. Each node has an
+// element which implements the Element interface
+// (GetKey() int) and two other Nodes.
+type Node struct {
+	element element.GetterSetter
+	left    *Node
+	right   *Node
+}
+
+// Element returns the element stored in the Node.
+func (n *Node) Element() element.GetterSetter {
+	return n.element
+}
+
+// Tree is a splay tree. Search, Insert and Remove splay the
+// Node they access to the root, rotating it up while roughly
+// halving the depth of the Nodes in its path, so recently
+// accessed keys are found close to the root. A single operation
+// may take O(n), but any sequence of m operations takes
+// O(m log n), and skewed access patterns take much less. Note
+// that, since Search changes the shape of the Tree, it is not
+// read-only.
+type Tree struct {
+	root *Node
+	size int
+}
+
+// Insert adds element to the Tree and makes it the root. The
+// Tree is first splayed by the element's key and then split
+// into the children of the new root. An element whose key
+// is already in the Tree gets a Node of its own, next to the
+// existing ones in InOrder.
+func (t *Tree) Insert(element element.GetterSetter) {
+	node := &Node{element: element}
+	t.size++
+	if t.root == nil {
+		t.root = node
+		return
+	}
+
+	key := element.GetKey()
+	t.root = splay(t.root, byKey(key))
+	if key < t.root.element.GetKey() {
+		node.left = t.root.left
+		node.right = t.root
+		t.root.left = nil
+	} else {
+		node.right = t.root.right
+		node.left = t.root
+		t.root.right = nil
+	}
+	t.root = node
+}
+
+// Search returns the Node holding key, or nil if there is
+// no such Node in the Tree. Either way the Tree is splayed
+// by key, bringing the Node holding it, or the last Node
+// visited looking for it, to the root.
+func (t *Tree) Search(key int) *Node {
+	t.root = splay(t.root, byKey(key))
+	if t.root == nil || t.root.element.GetKey() != key {
+		return nil
+	}
+	return t.root
+}
+
+// Remove removes a Node holding key from the Tree, if any.
+// The Node is splayed to the root and replaced by the largest
+// Node of its left subtree, splayed to the root of that
+// subtree, so it has no right child to be replaced by the
+// right subtree.
+func (t *Tree) Remove(key int) {
+	t.root = splay(t.root, byKey(key))
+	if t.root == nil || t.root.element.GetKey() != key {
+		return
+	}
+
+	removed := t.root
+	if removed.left == nil {
+		t.root = removed.right
+	} else {
+		t.root = splay(removed.left, toMax)
+		t.root.right = removed.right
+	}
+	removed.left, removed.right = nil, nil
+	t.size--
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return t.size
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree) Height() int {
+	return height(t.root)
+}
+
+func (t *Tree) Traverse(algorithm TraverseAlgorithm) string {
+	switch algorithm {
+	case InOrder:
+		return t.inOrder(t.root)
+	case PreOrder:
+		return t.preOrder(t.root)
+	case PostOrder:
+		return t.postOrder(t.root)
+	default:
+		return "unknown traversal algorithm"
+	}
+}
+
+func (t *Tree) inOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.inOrder(root.left)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.inOrder(root.right)
+	return result
+}
+
+func (t *Tree) preOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.preOrder(root.left)
+	result += t.preOrder(root.right)
+	return result
+}
+
+func (t *Tree) postOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.postOrder(root.left)
+	result += t.postOrder(root.right)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	return result
+}
+
+// splay brings to the root the Node found by going down from
+// root in the direction given by compare, negative for left,
+// positive for right and zero to stop, or the last Node in that
+// path, and returns it. It is top-down: the Nodes left behind
+// on the way down are linked into a left tree, with the Nodes
+// smaller than the target, and a right tree, with the larger
+// ones, which become the children of the target in the end.
+// Two steps in the same direction rotate first (zig-zig),
+// which is what keeps the amortized cost O(log n).
+func splay(root *Node, compare func(*Node) int) *Node {
+	if root == nil {
+		return nil
+	}
+
+	// header.right is the root of the left tree and header.left
+	// the root of the right tree, while left and right are their
+	// largest and smallest Nodes, where the next ones are linked
+	var header Node
+	left, right := &header, &header
+	current := root
+	for {
+		direction := compare(current)
+		if direction < 0 {
+			if current.left == nil {
+				break
+			}
+			if compare(current.left) < 0 {
+				// zig-zig, rotate right
+				child := current.left
+				current.left = child.right
+				child.right = current
+				current = child
+				if current.left == nil {
+					break
+				}
+			}
+			// link current to the right tree
+			right.left = current
+			right = current
+			current = current.left
+			continue
+		}
+		if direction > 0 {
+			if current.right == nil {
+				break
+			}
+			if compare(current.right) > 0 {
+				// zig-zig, rotate left
+				child := current.right
+				current.right = child.left
+				child.left = current
+				current = child
+				if current.right == nil {
+					break
+				}
+			}
+			// link current to the left tree
+			left.right = current
+			left = current
+			current = current.right
+			continue
+		}
+		break
+	}
+
+	// reassemble the left tree, current and the right tree
+	left.right = current.left
+	right.left = current.right
+	current.left = header.right
+	current.right = header.left
+	return current
+}
+
+// byKey returns the direction to take from a Node
+// when looking for key.
+func byKey(key int) func(*Node) int {
+	return func(node *Node) int {
+		return cmp.Compare(key, node.element.GetKey())
+	}
+}
+
+// toMax always goes right, to the largest Node.
+func toMax(*Node) int {
+	return 1
+}
+
+func height(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return 1 + max(height(node.left), height(node.right))
+}
+
+func NewTree() *Tree {
+	return &Tree{}
+}
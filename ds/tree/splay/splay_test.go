@@ -0,0 +1,235 @@
+package splay_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/binary"
+	"github.com/felipebool/dsa/ds/tree/splay"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	key int
+}
+
+func (e item) GetKey() int {
+	return e.key
+}
+
+func (e item) SetKey(key int) {
+	e.key = key
+}
+
+func TestSplayTreeInsertion(t *testing.T) {
+	testCases := map[string]struct {
+		elements          []element.GetterSetter
+		expectedInOrder   string
+		expectedPreOrder  string
+		expectedPostOrder string
+	}{
+		"random elements": {
+			elements: []element.GetterSetter{
+				item{key: 8},
+				item{key: 3},
+				item{key: 10},
+				item{key: 1},
+				item{key: 6},
+				item{key: 14},
+				item{key: 4},
+				item{key: 7},
+				item{key: 13},
+			},
+			expectedInOrder:   "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder:  "[13] [8] [7] [4] [3] [1] [6] [10] [14] ",
+			expectedPostOrder: "[1] [3] [6] [4] [7] [10] [8] [14] [13] ",
+		},
+		"ascending elements": {
+			elements: []element.GetterSetter{
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+				item{key: 4},
+			},
+			expectedInOrder:   "[1] [2] [3] [4] ",
+			expectedPreOrder:  "[4] [3] [2] [1] ",
+			expectedPostOrder: "[1] [2] [3] [4] ",
+		},
+		"duplicated elements": {
+			elements: []element.GetterSetter{
+				item{key: 2},
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+			},
+			expectedInOrder:   "[1] [2] [2] [3] ",
+			expectedPreOrder:  "[3] [2] [2] [1] ",
+			expectedPostOrder: "[1] [2] [2] [3] ",
+		},
+		"no elements": {
+			elements:          []element.GetterSetter{},
+			expectedInOrder:   "",
+			expectedPreOrder:  "",
+			expectedPostOrder: "",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := splay.NewTree()
+			for _, i := range tc.elements {
+				tree.Insert(i)
+			}
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(splay.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(splay.PreOrder))
+			assert.Equal(t, tc.expectedPostOrder, tree.Traverse(splay.PostOrder))
+			assert.Equal(t, len(tc.elements), tree.Len())
+
+			for _, i := range tc.elements {
+				node := tree.Search(i.GetKey())
+				if assert.NotNil(t, node) {
+					assert.Equal(t, i.GetKey(), node.Element().GetKey())
+				}
+				// the Node found is now the root
+				root := fmt.Sprintf("[%d] ", i.GetKey())
+				assert.True(t, strings.HasPrefix(tree.Traverse(splay.PreOrder), root))
+			}
+			assert.Nil(t, tree.Search(100))
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(splay.InOrder))
+		})
+	}
+}
+
+func TestSplayTreeRemove(t *testing.T) {
+	testCases := map[string]struct {
+		key              int
+		expectedInOrder  string
+		expectedPreOrder string
+		expectedLen      int
+	}{
+		"leaf": {
+			key:              13,
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [14] ",
+			expectedPreOrder: "[10] [8] [7] [4] [3] [1] [6] [14] ",
+			expectedLen:      8,
+		},
+		"root": {
+			key:              7,
+			expectedInOrder:  "[1] [3] [4] [6] [8] [10] [13] [14] ",
+			expectedPreOrder: "[6] [4] [3] [1] [8] [13] [10] [14] ",
+			expectedLen:      8,
+		},
+		"smallest key": {
+			key:              1,
+			expectedInOrder:  "[3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[4] [3] [7] [6] [8] [13] [10] [14] ",
+			expectedLen:      8,
+		},
+		"missing key": {
+			key:              5,
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[6] [4] [3] [1] [7] [8] [13] [10] [14] ",
+			expectedLen:      9,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := splay.NewTree()
+			for _, key := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13} {
+				tree.Insert(item{key: key})
+			}
+			tree.Search(7)
+
+			tree.Remove(tc.key)
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(splay.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(splay.PreOrder))
+			assert.Equal(t, tc.expectedLen, tree.Len())
+			assert.Nil(t, tree.Search(tc.key))
+		})
+	}
+}
+
+func TestSplayTreeRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	tree := splay.NewTree()
+	var keys []int
+	for range 5_000 {
+		key := r.IntN(1_000)
+		switch r.IntN(3) {
+		case 0:
+			tree.Remove(key)
+			if i := slices.Index(keys, key); i >= 0 {
+				keys = slices.Delete(keys, i, i+1)
+			}
+		case 1:
+			tree.Insert(item{key: key})
+			keys = append(keys, key)
+		default:
+			assert.Equal(t, slices.Contains(keys, key), tree.Search(key) != nil)
+		}
+	}
+
+	slices.Sort(keys)
+	var expected strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&expected, "[%d] ", key)
+	}
+	assert.Equal(t, expected.String(), tree.Traverse(splay.InOrder))
+	assert.Equal(t, len(keys), tree.Len())
+}
+
+// zipfKeys returns n keys in [0, size) drawn from a Zipf
+// distribution, where the hot keys are spread over the whole
+// range instead of being the smallest ones.
+func zipfKeys(n, size int) []int {
+	r := rand.New(rand.NewPCG(1, 2))
+	zipf := rand.NewZipf(r, 1.1, 1, uint64(size-1))
+	hot := r.Perm(size)
+
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = hot[zipf.Uint64()]
+	}
+	return keys
+}
+
+func BenchmarkZipfSearch(b *testing.B) {
+	const size = 100_000
+	inserted := rand.New(rand.NewPCG(3, 4)).Perm(size)
+	accessed := zipfKeys(1<<16, size)
+
+	b.Run("splay", func(b *testing.B) {
+		tree := splay.NewTree()
+		for _, key := range inserted {
+			tree.Insert(item{key: key})
+		}
+		b.ResetTimer()
+		for i := range b.N {
+			tree.Search(accessed[i%len(accessed)])
+		}
+	})
+
+	b.Run("binary", func(b *testing.B) {
+		tree := binary.NewTree()
+		for _, key := range inserted {
+			tree.Insert(item{key: key})
+		}
+		b.ResetTimer()
+		for i := range b.N {
+			tree.Search(accessed[i%len(accessed)])
+		}
+	})
+}
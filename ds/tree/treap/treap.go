@@ -0,0 +1,318 @@
+// This package implements a treap, a binary search tree
+// that keeps itself balanced by giving random priorities
+// to its nodes
+
+package treap
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/felipebool/dsa/ds/element"
+)
+
+const (
+	InOrder TraverseAlgorithm = iota
+	PreOrder
+	PostOrder
+)
+
+type TraverseAlgorithm int
+
+var (
+	// ErrOverlap is returned by Merge when the keys of the
+	// other Tree are not all greater than or equal to the
+	// keys of the Tree.
+	ErrOverlap = errors.New("treap: overlapping keys")
+
+	// ErrSelfMerge is returned by Merge when a Tree
+	// is merged with itself.
+	ErrSelfMerge = errors.New("treap: merge with itself")
+
+	// ErrNilTree is returned by Merge when
+	// the other Tree is nil.
+	ErrNilTree = errors.New("treap: merge with nil tree")
+)
+
+// Node represents a node in a Tree. Each node has an
+// element which implements the Element interface
+// (GetKey() int), a random priority, two other Nodes and
+// the number of elements in the subtree rooted at it. The
+// Node's key orders it among its subtrees as in a binary
+// search tree, while its priority is larger than or equal
+// to the priorities in both subtrees, as in a max heap.
+type Node struct {
+	element  element.GetterSetter
+	priority uint64
+	left     *Node
+	right    *Node
+	size     int
+}
+
+// Element returns the element stored in the Node.
+func (n *Node) Element() element.GetterSetter {
+	return n.element
+}
+
+// Tree is a treap. Its shape is the one a binary.Tree would
+// have if the elements were inserted in decreasing order of
+// priority, and since priorities are random, its expected
+// height is O(log n) whatever the insertion order, making
+// Search, Insert, Remove, Split and Merge O(log n) expected.
+// Priorities are drawn from a generator seeded by NewTree, so
+// the same seed and operations always give the same Tree. The
+// zero value is an empty Tree seeded as by NewTree(0).
+type Tree struct {
+	root   *Node
+	random *rand.Rand
+}
+
+// Insert adds element to the Tree with a new random priority.
+// The new Node goes down from the root as in a binary search
+// tree until it finds a Node with a lower priority, and takes
+// its place, with the subtree rooted there split by the new
+// key into its children. An element whose key is already in
+// the Tree gets a Node of its own, next to the existing ones
+// in InOrder.
+func (t *Tree) Insert(element element.GetterSetter) {
+	node := &Node{element: element, priority: t.generator().Uint64(), size: 1}
+	t.root = t.insert(t.root, node)
+}
+
+// Search returns the Node holding key, or nil if
+// there is no such Node in the Tree.
+func (t *Tree) Search(key int) *Node {
+	current := t.root
+	for current != nil {
+		if key > current.element.GetKey() {
+			current = current.right
+			continue
+		}
+		if key < current.element.GetKey() {
+			current = current.left
+			continue
+		}
+		return current
+	}
+	return nil
+}
+
+// Remove removes a Node holding key from the Tree, if any,
+// replacing it by the merge of its subtrees.
+func (t *Tree) Remove(key int) {
+	t.root = t.remove(t.root, key)
+}
+
+// Split moves every element with key greater than or equal
+// to key out of the Tree, into a new Tree that it returns,
+// leaving only the ones with smaller keys. The new Tree draws
+// its priorities from the same generator as the Tree.
+func (t *Tree) Split(key int) *Tree {
+	var right *Node
+	t.root, right = split(t.root, key)
+	return &Tree{root: right, random: t.generator()}
+}
+
+// Merge moves every element of other into the Tree, leaving
+// other empty. Every key in other must be greater than or
+// equal to the keys in the Tree, otherwise ErrOverlap is
+// returned and both Trees are left untouched. Merging a Tree
+// with itself returns ErrSelfMerge and with nil returns
+// ErrNilTree, leaving it untouched.
+func (t *Tree) Merge(other *Tree) error {
+	if other == nil {
+		return ErrNilTree
+	}
+	if other == t {
+		return ErrSelfMerge
+	}
+	if t.root != nil && other.root != nil &&
+		rightMost(t.root).element.GetKey() > leftMost(other.root).element.GetKey() {
+		return ErrOverlap
+	}
+	t.root = merge(t.root, other.root)
+	other.root = nil
+	return nil
+}
+
+// Len returns the number of elements in the Tree.
+func (t *Tree) Len() int {
+	return size(t.root)
+}
+
+// Height returns the number of levels in the Tree,
+// 0 for an empty Tree.
+func (t *Tree) Height() int {
+	return height(t.root)
+}
+
+func (t *Tree) Traverse(algorithm TraverseAlgorithm) string {
+	switch algorithm {
+	case InOrder:
+		return t.inOrder(t.root)
+	case PreOrder:
+		return t.preOrder(t.root)
+	case PostOrder:
+		return t.postOrder(t.root)
+	default:
+		return "unknown traversal algorithm"
+	}
+}
+
+// generator returns the generator of the Tree's priorities,
+// creating it on first use if the Tree is a zero value.
+func (t *Tree) generator() *rand.Rand {
+	if t.random == nil {
+		t.random = newGenerator(0)
+	}
+	return t.random
+}
+
+func (t *Tree) insert(root, node *Node) *Node {
+	if root == nil {
+		return node
+	}
+	if node.priority > root.priority {
+		node.left, node.right = split(root, node.element.GetKey())
+		update(node)
+		return node
+	}
+	if node.element.GetKey() < root.element.GetKey() {
+		root.left = t.insert(root.left, node)
+	} else {
+		root.right = t.insert(root.right, node)
+	}
+	update(root)
+	return root
+}
+
+func (t *Tree) remove(root *Node, key int) *Node {
+	if root == nil {
+		return nil
+	}
+	switch {
+	case key < root.element.GetKey():
+		root.left = t.remove(root.left, key)
+	case key > root.element.GetKey():
+		root.right = t.remove(root.right, key)
+	default:
+		return merge(root.left, root.right)
+	}
+	update(root)
+	return root
+}
+
+func (t *Tree) inOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.inOrder(root.left)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.inOrder(root.right)
+	return result
+}
+
+func (t *Tree) preOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := fmt.Sprintf("[%d] ", root.element.GetKey())
+	result += t.preOrder(root.left)
+	result += t.preOrder(root.right)
+	return result
+}
+
+func (t *Tree) postOrder(root *Node) string {
+	if root == nil {
+		return ""
+	}
+	result := t.postOrder(root.left)
+	result += t.postOrder(root.right)
+	result += fmt.Sprintf("[%d] ", root.element.GetKey())
+	return result
+}
+
+// split splits the subtree rooted at root into one with the
+// keys smaller than key and another with the remaining ones,
+// walking down a single path from root.
+func split(root *Node, key int) (*Node, *Node) {
+	if root == nil {
+		return nil, nil
+	}
+	if root.element.GetKey() < key {
+		var right *Node
+		root.right, right = split(root.right, key)
+		update(root)
+		return root, right
+	}
+	var left *Node
+	left, root.left = split(root.left, key)
+	update(root)
+	return left, root
+}
+
+// merge joins the subtrees rooted at left and right, where
+// every key in left is smaller than or equal to the keys in
+// right, picking the root with the higher priority at each
+// step down the right spine of left and the left spine of right.
+func merge(left, right *Node) *Node {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.right = merge(left.right, right)
+		update(left)
+		return left
+	}
+	right.left = merge(left, right.left)
+	update(right)
+	return right
+}
+
+func leftMost(node *Node) *Node {
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func rightMost(node *Node) *Node {
+	for node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
+func size(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func height(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return 1 + max(height(node.left), height(node.right))
+}
+
+func update(node *Node) {
+	node.size = 1 + size(node.left) + size(node.right)
+}
+
+// NewTree returns a new Tree with no elements, drawing the
+// priorities of its Nodes from a PCG generator seeded by seed.
+func NewTree(seed uint64) *Tree {
+	return &Tree{
+		random: newGenerator(seed),
+	}
+}
+
+func newGenerator(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
@@ -0,0 +1,305 @@
+package treap_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/felipebool/dsa/ds/element"
+	"github.com/felipebool/dsa/ds/tree/treap"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	key int
+}
+
+func (e item) GetKey() int {
+	return e.key
+}
+
+func (e item) SetKey(key int) {
+	e.key = key
+}
+
+func TestTreapInsertion(t *testing.T) {
+	testCases := map[string]struct {
+		elements         []element.GetterSetter
+		expectedInOrder  string
+		expectedPreOrder string
+	}{
+		"random elements": {
+			elements: []element.GetterSetter{
+				item{key: 8},
+				item{key: 3},
+				item{key: 10},
+				item{key: 1},
+				item{key: 6},
+				item{key: 14},
+				item{key: 4},
+				item{key: 7},
+				item{key: 13},
+			},
+			expectedInOrder:  "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedPreOrder: "[8] [1] [4] [3] [6] [7] [14] [10] [13] ",
+		},
+		"ascending elements": {
+			elements: []element.GetterSetter{
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+				item{key: 4},
+				item{key: 5},
+				item{key: 6},
+				item{key: 7},
+			},
+			expectedInOrder:  "[1] [2] [3] [4] [5] [6] [7] ",
+			expectedPreOrder: "[1] [6] [4] [3] [2] [5] [7] ",
+		},
+		"duplicated elements": {
+			elements: []element.GetterSetter{
+				item{key: 2},
+				item{key: 1},
+				item{key: 2},
+				item{key: 3},
+				item{key: 2},
+			},
+			expectedInOrder:  "[1] [2] [2] [2] [3] ",
+			expectedPreOrder: "[2] [1] [3] [2] [2] ",
+		},
+		"no elements": {
+			elements:         []element.GetterSetter{},
+			expectedInOrder:  "",
+			expectedPreOrder: "",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := treap.NewTree(1)
+			for _, i := range tc.elements {
+				tree.Insert(i)
+			}
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(treap.InOrder))
+			assert.Equal(t, tc.expectedPreOrder, tree.Traverse(treap.PreOrder))
+			assert.Equal(t, len(tc.elements), tree.Len())
+
+			for _, i := range tc.elements {
+				node := tree.Search(i.GetKey())
+				if assert.NotNil(t, node) {
+					assert.Equal(t, i, node.Element())
+				}
+			}
+			assert.Nil(t, tree.Search(100))
+		})
+	}
+}
+
+func TestTreapRemove(t *testing.T) {
+	testCases := map[string]struct {
+		keys            []int
+		remove          []int
+		expectedInOrder string
+		expectedLen     int
+	}{
+		"leaf and inner nodes": {
+			keys:            []int{8, 3, 10, 1, 6, 14, 4, 7, 13},
+			remove:          []int{13, 3, 8},
+			expectedInOrder: "[1] [4] [6] [7] [10] [14] ",
+			expectedLen:     6,
+		},
+		"duplicated keys": {
+			keys:            []int{2, 1, 2, 3, 2},
+			remove:          []int{2, 2},
+			expectedInOrder: "[1] [2] [3] ",
+			expectedLen:     3,
+		},
+		"missing key": {
+			keys:            []int{2, 1, 3},
+			remove:          []int{5},
+			expectedInOrder: "[1] [2] [3] ",
+			expectedLen:     3,
+		},
+		"every key": {
+			keys:            []int{2, 1, 3},
+			remove:          []int{1, 2, 3},
+			expectedInOrder: "",
+			expectedLen:     0,
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := treap.NewTree(1)
+			for _, key := range tc.keys {
+				tree.Insert(item{key: key})
+			}
+			for _, key := range tc.remove {
+				tree.Remove(key)
+			}
+
+			assert.Equal(t, tc.expectedInOrder, tree.Traverse(treap.InOrder))
+			assert.Equal(t, tc.expectedLen, tree.Len())
+		})
+	}
+}
+
+func TestTreapSplitMerge(t *testing.T) {
+	testCases := map[string]struct {
+		key           int
+		expectedLeft  string
+		expectedRight string
+	}{
+		"key in the tree": {
+			key:           6,
+			expectedLeft:  "[1] [3] [4] ",
+			expectedRight: "[6] [7] [8] [10] [13] [14] ",
+		},
+		"key not in the tree": {
+			key:           9,
+			expectedLeft:  "[1] [3] [4] [6] [7] [8] ",
+			expectedRight: "[10] [13] [14] ",
+		},
+		"key smaller than every key": {
+			key:           0,
+			expectedLeft:  "",
+			expectedRight: "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+		},
+		"key larger than every key": {
+			key:           20,
+			expectedLeft:  "[1] [3] [4] [6] [7] [8] [10] [13] [14] ",
+			expectedRight: "",
+		},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := treap.NewTree(1)
+			for _, key := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13} {
+				tree.Insert(item{key: key})
+			}
+			preOrder := tree.Traverse(treap.PreOrder)
+
+			right := tree.Split(tc.key)
+			assert.Equal(t, tc.expectedLeft, tree.Traverse(treap.InOrder))
+			assert.Equal(t, tc.expectedRight, right.Traverse(treap.InOrder))
+			assert.Equal(t, 9, tree.Len()+right.Len())
+
+			// merging the halves back gives the same treap,
+			// since its shape only depends on the priorities
+			assert.NoError(t, tree.Merge(right))
+			assert.Equal(t, preOrder, tree.Traverse(treap.PreOrder))
+			assert.Equal(t, 9, tree.Len())
+			assert.Equal(t, 0, right.Len())
+		})
+	}
+}
+
+func TestTreapMergeOverlap(t *testing.T) {
+	left, right := treap.NewTree(1), treap.NewTree(2)
+	for _, key := range []int{1, 5, 9} {
+		left.Insert(item{key: key})
+	}
+	for _, key := range []int{9, 12, 4} {
+		right.Insert(item{key: key})
+	}
+
+	assert.ErrorIs(t, left.Merge(right), treap.ErrOverlap)
+	assert.Equal(t, "[1] [5] [9] ", left.Traverse(treap.InOrder))
+	assert.Equal(t, "[4] [9] [12] ", right.Traverse(treap.InOrder))
+
+	right.Remove(4)
+	assert.NoError(t, left.Merge(right))
+	assert.Equal(t, "[1] [5] [9] [9] [12] ", left.Traverse(treap.InOrder))
+}
+
+func TestTreapMergeItself(t *testing.T) {
+	testCases := map[string]struct {
+		keys []int
+	}{
+		"single element": {keys: []int{1}},
+		"equal keys":     {keys: []int{2, 2, 2}},
+		"different keys": {keys: []int{3, 1, 2}},
+		"no elements":    {keys: []int{}},
+	}
+
+	for label := range testCases {
+		tc := testCases[label]
+		t.Run(label, func(t *testing.T) {
+			t.Parallel()
+
+			tree := treap.NewTree(1)
+			for _, key := range tc.keys {
+				tree.Insert(item{key: key})
+			}
+			preOrder := tree.Traverse(treap.PreOrder)
+
+			assert.ErrorIs(t, tree.Merge(tree), treap.ErrSelfMerge)
+			assert.Equal(t, len(tc.keys), tree.Len())
+			assert.Equal(t, preOrder, tree.Traverse(treap.PreOrder))
+		})
+	}
+}
+
+func TestTreapMergeNil(t *testing.T) {
+	tree := treap.NewTree(1)
+	for _, key := range []int{3, 1, 2} {
+		tree.Insert(item{key: key})
+	}
+
+	assert.ErrorIs(t, tree.Merge(nil), treap.ErrNilTree)
+	assert.Equal(t, "[1] [2] [3] ", tree.Traverse(treap.InOrder))
+}
+
+func TestTreapZeroValue(t *testing.T) {
+	var tree treap.Tree
+	seeded := treap.NewTree(0)
+	for _, key := range []int{5, 2, 8, 1, 9, 3} {
+		tree.Insert(item{key: key})
+		seeded.Insert(item{key: key})
+	}
+
+	assert.Equal(t, seeded.Traverse(treap.PreOrder), tree.Traverse(treap.PreOrder))
+	assert.Equal(t, "[1] [2] [3] [5] [8] [9] ", tree.Traverse(treap.InOrder))
+
+	var empty treap.Tree
+	right := empty.Split(0)
+	right.Insert(item{key: 4})
+	assert.NoError(t, empty.Merge(right))
+	assert.Equal(t, "[4] ", empty.Traverse(treap.InOrder))
+}
+
+func TestTreapDeterministic(t *testing.T) {
+	build := func(seed uint64) *treap.Tree {
+		tree := treap.NewTree(seed)
+		for key := range 100 {
+			tree.Insert(item{key: key})
+		}
+		return tree
+	}
+
+	assert.Equal(t, build(7).Traverse(treap.PreOrder), build(7).Traverse(treap.PreOrder))
+	assert.NotEqual(t, build(7).Traverse(treap.PreOrder), build(8).Traverse(treap.PreOrder))
+}
+
+func TestTreapSortedInsertionHeight(t *testing.T) {
+	const n = 100_000
+
+	tree := treap.NewTree(1)
+	for key := range n {
+		tree.Insert(item{key: key})
+	}
+
+	// the expected height is about 3 log2(n)
+	assert.Equal(t, n, tree.Len())
+	assert.LessOrEqual(t, float64(tree.Height()), 4*math.Log2(n))
+}